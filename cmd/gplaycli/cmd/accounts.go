@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/keyring"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"path/filepath"
+	"strconv"
+)
+
+var (
+	accountLocale  string
+	accountNoLogin bool
+)
+
+func init() {
+	accountsAddCmd.Flags().StringVar(&accountLocale, "locale", "", "Locale of the account, e.g., \"fi-FI\"")
+	accountsAddCmd.Flags().BoolVar(&accountNoLogin, "no-login", false,
+		"Only save the profile, login later with \"gplay login --profile NAME\"")
+
+	accountsCmd.AddCommand(accountsListCmd)
+	accountsCmd.AddCommand(accountsAddCmd)
+	accountsCmd.AddCommand(accountsRemoveCmd)
+	accountsCmd.AddCommand(accountsDefaultCmd)
+
+	rootCmd.AddCommand(accountsCmd)
+}
+
+var accountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "Manage the named account profiles in the token store",
+	Long: "An account profile has its own tokens, email, device profile and locale, " +
+		"e.g., for different regions or devices. Select a profile with --profile, the default profile is used otherwise",
+}
+
+var accountsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the account profiles",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getTokenStore()
+		if err != nil {
+			return err
+		}
+
+		profiles, defaultProfile, err := keyring.Profiles(store)
+		if err != nil {
+			return err
+		}
+
+		res := accountsListResult{}
+		for _, profile := range profiles {
+			_, authSub, err := keyring.GetProfileGoogleTokens(store, profile.Name)
+			res = append(res, accountInfo{
+				Profile:  profile,
+				Default:  profile.Name == defaultProfile,
+				LoggedIn: err == nil && authSub != "",
+			})
+		}
+		return printOutput(cmd.OutOrStdout(), res)
+	},
+}
+
+var accountsAddCmd = &cobra.Command{
+	Use:   "add NAME",
+	Short: "Add or update an account profile and login with it",
+	Long: "Save the --email, --device-profile and --locale of the profile, then login. " +
+		"The first profile becomes the default",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getTokenStore()
+		if err != nil {
+			return err
+		}
+
+		profile := keyring.Profile{Name: args[0], Email: email, Locale: accountLocale}
+		if deviceProfilePath != "" {
+			// The profile is used from any working directory
+			if profile.DeviceProfile, err = filepath.Abs(deviceProfilePath); err != nil {
+				return err
+			}
+		}
+
+		if err = keyring.SaveProfile(store, profile); err != nil {
+			return err
+		}
+		log.Infof("Saved profile %s", profile.Name)
+
+		if accountNoLogin {
+			return nil
+		}
+
+		gplay, err := createProfilePlaystoreClient(profile.Name)
+		if err != nil {
+			return err
+		}
+		if err = gplay.GetAuthClient().Authenticate(); err != nil {
+			return err
+		}
+		log.Infof("Logged in with profile %s", profile.Name)
+		return nil
+	},
+}
+
+var accountsRemoveCmd = &cobra.Command{
+	Use:   "remove NAME",
+	Short: "Remove an account profile and its tokens",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getTokenStore()
+		if err != nil {
+			return err
+		}
+
+		if err = keyring.RemoveProfile(store, args[0]); err != nil {
+			return err
+		}
+		log.Infof("Removed profile %s", args[0])
+		return nil
+	},
+}
+
+var accountsDefaultCmd = &cobra.Command{
+	Use:   "default [NAME]",
+	Short: "Set the default account profile, show it if NAME is not given",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getTokenStore()
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			if err = keyring.SetDefaultProfile(store, args[0]); err != nil {
+				return err
+			}
+			log.Infof("Default profile is %s", args[0])
+			return nil
+		}
+
+		profile, err := keyring.GetProfile(store, "")
+		if err != nil {
+			return err
+		}
+		if profile == nil {
+			return fmt.Errorf("default profile is not set")
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), profile.Name)
+		return err
+	},
+}
+
+type accountInfo struct {
+	keyring.Profile
+	Default  bool `json:"default"`
+	LoggedIn bool `json:"loggedIn"`
+}
+
+type accountsListResult []accountInfo
+
+func (res accountsListResult) header() []string {
+	return []string{"NAME", "EMAIL", "LOCALE", "DEVICE PROFILE", "DEFAULT", "LOGGED IN"}
+}
+
+func (res accountsListResult) rows() [][]string {
+	var rows [][]string
+	for _, account := range res {
+		rows = append(rows, []string{account.Name, account.Email, account.Locale, account.DeviceProfile,
+			strconv.FormatBool(account.Default), strconv.FormatBool(account.LoggedIn)})
+	}
+	return rows
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/apkinfo"
+	"github.com/jarijaas/go-gplayapi/pkg/bundle"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	rootCmd.AddCommand(apkinfoCmd)
+}
+
+var apkinfoCmd = &cobra.Command{
+	Use:   "apkinfo FILE",
+	Short: "Show the manifest and signing certificates of an APK file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info, err := apkinfo.Open(args[0])
+		if err != nil {
+			return err
+		}
+		return printOutput(cmd.OutOrStdout(), &apkinfoResult{info})
+	},
+}
+
+type apkinfoResult struct {
+	*apkinfo.ApkInfo
+}
+
+func (res *apkinfoResult) header() []string {
+	return nil
+}
+
+func (res *apkinfoResult) rows() [][]string {
+	rows := [][]string{
+		{"Package:", res.PackageName},
+		{"Version:", fmt.Sprintf("%s (%d)", res.VersionName, res.VersionCode)},
+		{"Min SDK:", strconv.Itoa(res.MinSdkVersion)},
+		{"Target SDK:", strconv.Itoa(res.TargetSdkVersion)},
+	}
+	rows = append(rows, listRows("Native ABIs:", res.NativeAbis)...)
+	rows = append(rows, listRows("Permissions:", res.Permissions)...)
+
+	var signatures []string
+	for _, signature := range res.Signatures {
+		for _, cert := range signature.CertSha256 {
+			signatures = append(signatures, fmt.Sprintf("v%d %s", signature.Scheme, cert))
+		}
+	}
+	return append(rows, listRows("Certificates:", signatures)...)
+}
+
+// Checks of the downloaded APKs, selected by the download flags
+type apkVerifier struct {
+	// Check package name and version code against the delivery data
+	manifest bool
+	// Check signing certificate against the pin, nil to skip
+	trust *playstore.TrustStore
+	// Only log changed signing certificates
+	trustWarnOnly bool
+}
+
+func (verifier *apkVerifier) enabled() bool {
+	return verifier != nil && (verifier.manifest || verifier.trust != nil)
+}
+
+func (verifier *apkVerifier) verify(apkPath string, downloadInfo *playstore.DownloadInfo) error {
+	if !verifier.enabled() {
+		return nil
+	}
+
+	info, err := apkinfo.Open(apkPath)
+	if err != nil {
+		return fmt.Errorf("could not verify %s: %v", apkPath, err)
+	}
+	return verifier.verifyInfo(apkPath, info, downloadInfo)
+}
+
+// The base APK in the bundle is checked
+func (verifier *apkVerifier) verifyBundle(
+	bundlePath string, format bundle.Format, b *bundle.Bundle, downloadInfo *playstore.DownloadInfo) error {
+	if !verifier.enabled() {
+		return nil
+	}
+
+	info, err := bundle.OpenApk(bundlePath, b.BaseApkPath(format))
+	if err != nil {
+		return fmt.Errorf("could not verify %s: %v", bundlePath, err)
+	}
+	return verifier.verifyInfo(bundlePath, info, downloadInfo)
+}
+
+func (verifier *apkVerifier) verifyInfo(
+	apkPath string, info *apkinfo.ApkInfo, downloadInfo *playstore.DownloadInfo) error {
+	var err error
+	if verifier.manifest {
+		if err = verifyApkManifest(apkPath, info, downloadInfo); err != nil {
+			return err
+		}
+	}
+
+	if verifier.trust == nil {
+		return nil
+	}
+
+	pinned, err := verifier.trust.Verify(downloadInfo.PackageName, downloadInfo.VersionCode, info.SigningCertSha256())
+	if _, ok := err.(*playstore.CertificateMismatchError); ok && verifier.trustWarnOnly {
+		log.Warnf("WARNING: %v. Check the app before using %s", err, apkPath)
+		return nil
+	}
+	if pinned {
+		log.Infof("Pinned signing certificate of %s", downloadInfo.PackageName)
+	}
+	return err
+}
+
+/**
+Check that the downloaded APK is the app and version the playstore delivered
+*/
+func verifyApkManifest(apkPath string, info *apkinfo.ApkInfo, downloadInfo *playstore.DownloadInfo) error {
+	var mismatches []string
+	if info.PackageName != downloadInfo.PackageName {
+		mismatches = append(mismatches, fmt.Sprintf("package name is %s, expected %s",
+			info.PackageName, downloadInfo.PackageName))
+	}
+	if info.VersionCode != downloadInfo.VersionCode {
+		mismatches = append(mismatches, fmt.Sprintf("version code is %d, expected %d",
+			info.VersionCode, downloadInfo.VersionCode))
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("manifest of %s does not match: %s", apkPath, strings.Join(mismatches, ", "))
+	}
+	return nil
+}
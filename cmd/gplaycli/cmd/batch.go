@@ -0,0 +1,410 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/auth"
+	"github.com/jarijaas/go-gplayapi/pkg/bundle"
+	"github.com/jarijaas/go-gplayapi/pkg/keyring"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// App to download, a row of the batch download manifest
+type manifestEntry struct {
+	PackageName string `yaml:"package"`
+	// Latest if zero
+	VersionCode int `yaml:"versionCode"`
+	// Account profile used for the download, see "gplay accounts". The --profile or default profile if empty
+	Profile string `yaml:"profile"`
+	// Device profile file the download checks in as, see "gplay profile import". The device of the account if empty
+	DeviceProfile string `yaml:"deviceProfile"`
+	// Relative to the download dir, "<package>.apk" if empty
+	Out string `yaml:"out"`
+}
+
+/**
+Read batch download manifest, the format is selected by file extension:
+
+	.yaml, .yml: list of entries with keys package, versionCode, profile, out and deviceProfile
+	.csv: columns package, versionCode, profile, out, deviceProfile. Header row is optional
+	otherwise: plain text, a package and an optional version code per line, # starts a comment
+*/
+func readManifest(manifestPath string) ([]manifestEntry, error) {
+	f, err := os.Open(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []manifestEntry
+
+	switch strings.ToLower(filepath.Ext(manifestPath)) {
+	case ".yaml", ".yml":
+		data, err := ioutil.ReadAll(f)
+		if err != nil {
+			return nil, err
+		}
+		err = yaml.UnmarshalStrict(data, &entries)
+		if err != nil {
+			return nil, err
+		}
+	case ".csv":
+		entries, err = readCSVManifest(f)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		entries, err = readTextManifest(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, entry := range entries {
+		if entry.PackageName == "" {
+			return nil, fmt.Errorf("manifest entry %d does not have package name", i+1)
+		}
+		// The package name is the default file name
+		if strings.ContainsAny(entry.PackageName, "/\\") {
+			return nil, fmt.Errorf("manifest entry %d: invalid package name %s", i+1, entry.PackageName)
+		}
+		if err = checkOutPath(entry.Out); err != nil {
+			return nil, fmt.Errorf("manifest entry %d: %v", i+1, err)
+		}
+	}
+	return entries, nil
+}
+
+// The output path must stay inside the download dir
+func checkOutPath(out string) error {
+	if out == "" {
+		return nil
+	}
+	if filepath.IsAbs(out) || strings.HasPrefix(out, "/") || strings.HasPrefix(out, "\\") {
+		return fmt.Errorf("output path %s must be relative to the download dir", out)
+	}
+
+	cleaned := filepath.Clean(out)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("output path %s is outside the download dir", out)
+	}
+	return nil
+}
+
+// File name of the entry relative to the download dir
+func entryOutName(entry manifestEntry, format *bundle.Format) string {
+	if entry.Out != "" {
+		return filepath.Clean(entry.Out)
+	}
+
+	extension := "." + formatApk
+	if format != nil {
+		extension = format.Extension()
+	}
+	if entry.VersionCode != 0 {
+		return fmt.Sprintf("%s-%d%s", entry.PackageName, entry.VersionCode, extension)
+	}
+	return entry.PackageName + extension
+}
+
+/**
+Check that no two entries are written to the same file, the concurrent downloads would overwrite
+and remove each other's file
+*/
+func checkManifestOutPaths(entries []manifestEntry, format *bundle.Format) error {
+	entryByPath := map[string]int{}
+
+	for i, entry := range entries {
+		outName := entryOutName(entry, format)
+		if first, ok := entryByPath[outName]; ok {
+			return fmt.Errorf("manifest entries %d and %d are both saved as %s", first+1, i+1, outName)
+		}
+		entryByPath[outName] = i
+	}
+	return nil
+}
+
+func readCSVManifest(r io.Reader) ([]manifestEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []manifestEntry
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "package") {
+			continue
+		}
+
+		for len(record) < 5 {
+			record = append(record, "")
+		}
+
+		entry := manifestEntry{
+			PackageName:   strings.TrimSpace(record[0]),
+			Profile:       strings.TrimSpace(record[2]),
+			Out:           strings.TrimSpace(record[3]),
+			DeviceProfile: strings.TrimSpace(record[4]),
+		}
+		if versionCode := strings.TrimSpace(record[1]); versionCode != "" {
+			entry.VersionCode, err = strconv.Atoi(versionCode)
+			if err != nil {
+				return nil, fmt.Errorf("manifest row %d: invalid version code: %v", i+1, err)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func readTextManifest(r io.Reader) ([]manifestEntry, error) {
+	var entries []manifestEntry
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		row := scanner.Text()
+		if idx := strings.Index(row, "#"); idx != -1 {
+			row = row[:idx]
+		}
+
+		fields := strings.Fields(row)
+		if len(fields) == 0 {
+			continue
+		}
+
+		entry := manifestEntry{PackageName: fields[0]}
+		if len(fields) > 1 {
+			versionCode, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("manifest line %d: invalid version code: %v", line, err)
+			}
+			entry.VersionCode = versionCode
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+/**
+Check that the account profiles of the manifest exist and the device profiles can be loaded,
+so an unknown profile fails the batch before anything is downloaded
+*/
+func checkManifestProfiles(entries []manifestEntry) error {
+	checked := map[string]bool{"": true}
+	checkedDevices := map[string]bool{"": true}
+
+	for i, entry := range entries {
+		if !checkedDevices[entry.DeviceProfile] {
+			if _, err := auth.LoadDeviceProfile(entry.DeviceProfile); err != nil {
+				return fmt.Errorf("manifest entry %d: %v", i+1, err)
+			}
+			checkedDevices[entry.DeviceProfile] = true
+		}
+
+		if checked[entry.Profile] {
+			continue
+		}
+
+		store, err := getTokenStore()
+		if err != nil {
+			return err
+		}
+		if _, err = keyring.GetProfile(store, entry.Profile); err != nil {
+			return fmt.Errorf("manifest entry %d: %v", i+1, err)
+		}
+		checked[entry.Profile] = true
+	}
+	return nil
+}
+
+// Download result of a manifest entry
+type batchResult struct {
+	PackageName string `json:"packageName"`
+	VersionCode int    `json:"versionCode,omitempty"`
+	Profile     string `json:"profile,omitempty"`
+	// Omitted if the device of the account was used
+	DeviceProfile string  `json:"deviceProfile,omitempty"`
+	Path          string  `json:"path,omitempty"`
+	Size          int64   `json:"size,omitempty"`
+	Sha256        string  `json:"sha256,omitempty"`
+	Success       bool    `json:"success"`
+	Error         string  `json:"error,omitempty"`
+	Seconds       float64 `json:"seconds"`
+}
+
+type batchReport []batchResult
+
+func (report batchReport) header() []string {
+	return []string{"PACKAGE", "STATUS", "SIZE", "SHA256", "PATH / ERROR"}
+}
+
+func (report batchReport) rows() [][]string {
+	rows := make([][]string, len(report))
+	for i, res := range report {
+		if res.Success {
+			rows[i] = []string{res.PackageName, "ok", strconv.FormatInt(res.Size, 10), res.Sha256, res.Path}
+		} else {
+			rows[i] = []string{res.PackageName, "failed", "", "", res.Error}
+		}
+	}
+	return rows
+}
+
+func (report batchReport) failed() int {
+	failed := 0
+	for _, res := range report {
+		if !res.Success {
+			failed++
+		}
+	}
+	return failed
+}
+
+/**
+Download the manifest entries using `workers` concurrent downloads
+
+A failed download does not stop the others, the errors are in the report.
+The report is in the manifest order
+*/
+func downloadBatch(entries []manifestEntry, downloadDir string, workers int, format *bundle.Format, verifier *apkVerifier) batchReport {
+	if workers < 1 {
+		workers = 1
+	}
+
+	report := make(batchReport, len(entries))
+	clients := &profileClients{clients: map[profileClientKey]*playstore.Client{}}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				report[i] = downloadManifestEntry(clients, entries[i], downloadDir, format, verifier)
+			}
+		}()
+	}
+
+	for i := range entries {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return report
+}
+
+func downloadManifestEntry(clients *profileClients, entry manifestEntry, downloadDir string, format *bundle.Format, verifier *apkVerifier) batchResult {
+	start := time.Now()
+	res := batchResult{
+		PackageName:   entry.PackageName,
+		VersionCode:   entry.VersionCode,
+		Profile:       entry.Profile,
+		DeviceProfile: entry.DeviceProfile,
+	}
+
+	res.Path = filepath.Join(downloadDir, entryOutName(entry, format))
+
+	size, sha256, err := downloadEntryToFile(clients, entry, res.Path, format, verifier)
+	res.Seconds = time.Since(start).Seconds()
+	if err != nil {
+		log.Errorf("Download %s failed: %v", entry.PackageName, err)
+		res.Path = ""
+		res.Error = err.Error()
+		return res
+	}
+
+	log.Infof("Downloaded %s to %s", entry.PackageName, res.Path)
+	res.Success = true
+	res.Size = size
+	res.Sha256 = hex.EncodeToString(sha256)
+	return res
+}
+
+// The partially downloaded file and the file failing verification are removed on error
+func downloadEntryToFile(
+	clients *profileClients, entry manifestEntry, filePath string, format *bundle.Format, verifier *apkVerifier) (size int64, sha256 []byte, err error) {
+	gplay, err := clients.get(entry.Profile, entry.DeviceProfile)
+	if err != nil {
+		return
+	}
+
+	if format != nil {
+		return downloadBundleToFile(gplay, entry.PackageName, entry.VersionCode, *format, filePath, verifier)
+	}
+
+	reader, downloadInfo, err := gplay.Download(entry.PackageName, entry.VersionCode)
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+
+	if err = os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return
+	}
+
+	f, err := os.Create(filePath)
+	if err != nil {
+		return
+	}
+
+	size, err = io.Copy(f, reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(filePath)
+		return
+	}
+
+	if err = verifier.verify(filePath, downloadInfo); err != nil {
+		_ = os.Remove(filePath)
+		return
+	}
+	return size, downloadInfo.Sha256, nil
+}
+
+type profileClientKey struct {
+	profile       string
+	deviceProfile string
+}
+
+// Playstore clients by account profile and device profile, created on first use
+type profileClients struct {
+	mutex   sync.Mutex
+	clients map[profileClientKey]*playstore.Client
+}
+
+func (pc *profileClients) get(profile string, deviceProfile string) (*playstore.Client, error) {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	key := profileClientKey{profile: profile, deviceProfile: deviceProfile}
+	if gplay, ok := pc.clients[key]; ok {
+		return gplay, nil
+	}
+
+	gplay, err := createDevicePlaystoreClient(profile, deviceProfile)
+	if err != nil {
+		return nil, err
+	}
+	pc.clients[key] = gplay
+	return gplay, nil
+}
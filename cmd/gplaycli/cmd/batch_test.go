@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/bundle"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeManifest(t *testing.T, name string, content string) string {
+	dir, err := ioutil.TempDir("", "gplay-manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	manifestPath := filepath.Join(dir, name)
+	if err = ioutil.WriteFile(manifestPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return manifestPath
+}
+
+func TestReadManifest(t *testing.T) {
+	expected := []manifestEntry{
+		{PackageName: "com.whatsapp"},
+		{PackageName: "com.example.game", VersionCode: 42, Profile: "fi", Out: "games/game.apk",
+			DeviceProfile: "pixel.json"},
+	}
+
+	manifests := map[string]string{
+		"apps.txt": "# nightly\ncom.whatsapp\n\ncom.example.game 42 # pinned\n",
+		"apps.csv": "package,versionCode,profile,out,deviceProfile\ncom.whatsapp\n" +
+			"com.example.game, 42, fi, games/game.apk, pixel.json\n",
+		"apps.yaml": "- package: com.whatsapp\n" +
+			"- package: com.example.game\n  versionCode: 42\n  profile: fi\n  out: games/game.apk\n" +
+			"  deviceProfile: pixel.json\n",
+	}
+
+	for name, content := range manifests {
+		entries, err := readManifest(writeManifest(t, name, content))
+		if err != nil {
+			t.Fatalf("Could not read %s: %v", name, err)
+		}
+
+		want := expected
+		if name == "apps.txt" {
+			// Plain text has only the package and version code
+			want = []manifestEntry{expected[0], {PackageName: "com.example.game", VersionCode: 42}}
+		}
+		if !reflect.DeepEqual(entries, want) {
+			t.Fatalf("Entries of %s are incorrect: %+v, should be: %+v", name, entries, want)
+		}
+	}
+
+	invalid := map[string]string{
+		"version.txt": "com.whatsapp latest\n",
+		"package.csv": ",42\n",
+		"key.yaml":    "- package: com.whatsapp\n  version: 42\n",
+		"out.csv":     "com.whatsapp,,,../whatsapp.apk\n",
+	}
+	for name, content := range invalid {
+		if entries, err := readManifest(writeManifest(t, name, content)); err == nil {
+			t.Fatalf("Invalid manifest %s should fail: %+v", name, entries)
+		}
+	}
+}
+
+func TestCheckOutPath(t *testing.T) {
+	for _, out := range []string{"", "whatsapp.apk", "apps/whatsapp.apk", "apps/../whatsapp.apk", "./whatsapp.apk"} {
+		if err := checkOutPath(out); err != nil {
+			t.Fatalf("Output path %s should be valid: %v", out, err)
+		}
+	}
+
+	for _, out := range []string{"/tmp/whatsapp.apk", "\\whatsapp.apk", "..", ".", "../whatsapp.apk",
+		"apps/../../whatsapp.apk"} {
+		if err := checkOutPath(out); err == nil {
+			t.Fatalf("Output path %s should be rejected", out)
+		}
+	}
+}
+
+func TestCheckManifestOutPaths(t *testing.T) {
+	entries := []manifestEntry{
+		{PackageName: "com.whatsapp"},
+		{PackageName: "com.whatsapp", VersionCode: 42},
+		{PackageName: "com.example.game", Out: "game.apk"},
+	}
+	if err := checkManifestOutPaths(entries, nil); err != nil {
+		t.Fatalf("Entries with different output paths should be valid: %v", err)
+	}
+
+	duplicates := [][]manifestEntry{
+		{{PackageName: "com.whatsapp"}, {PackageName: "com.whatsapp"}},
+		{{PackageName: "com.whatsapp", Out: "app.apk"}, {PackageName: "com.example.game", Out: "./app.apk"}},
+		{{PackageName: "com.whatsapp"}, {PackageName: "com.example.game", Out: "com.whatsapp.apk"}},
+	}
+	for _, entries := range duplicates {
+		if err := checkManifestOutPaths(entries, nil); err == nil {
+			t.Fatalf("Entries with the same output path should be rejected: %+v", entries)
+		}
+	}
+
+	// The default file names have the extension of the format
+	xapk := bundle.FormatXapk
+	entries = []manifestEntry{{PackageName: "com.whatsapp"}, {PackageName: "com.example.game", Out: "com.whatsapp.apk"}}
+	if err := checkManifestOutPaths(entries, &xapk); err != nil {
+		t.Fatalf("Entries with different extensions should be valid: %v", err)
+	}
+}
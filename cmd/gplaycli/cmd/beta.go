@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"strconv"
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{betaStatusCmd, betaJoinCmd, betaLeaveCmd} {
+		cmd.Flags().StringVar(&appPackageName, "id", "", "The app package name e.g., \"com.whatsapp\"")
+		_ = cmd.MarkFlagRequired("id")
+		betaCmd.AddCommand(cmd)
+	}
+
+	rootCmd.AddCommand(betaCmd)
+}
+
+var betaCmd = &cobra.Command{
+	Use:   "beta",
+	Short: "Manage beta testing program enrollment",
+}
+
+var betaStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show whether the account is enrolled to the app beta",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		info, err := gplay.GetTestingProgram(appPackageName)
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd.OutOrStdout(), &betaResult{
+			PackageName:       appPackageName,
+			HasTestingProgram: info != nil,
+			Subscribed:        info.GetSubscribed(),
+			FeedbackEmail:     info.GetTestingProgramEmail(),
+		})
+	},
+}
+
+var betaJoinCmd = &cobra.Command{
+	Use:   "join",
+	Short: "Join the app beta, downloads return the beta build afterwards",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setTestingProgram(cmd, true)
+	},
+}
+
+var betaLeaveCmd = &cobra.Command{
+	Use:   "leave",
+	Short: "Leave the app beta",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setTestingProgram(cmd, false)
+	},
+}
+
+func setTestingProgram(cmd *cobra.Command, subscribe bool) error {
+	gplay, err := createPlaystoreClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = gplay.SetTestingProgram(appPackageName, subscribe)
+	if err != nil {
+		return err
+	}
+
+	if subscribe {
+		log.Infof("Joined %s beta", appPackageName)
+	} else {
+		log.Infof("Left %s beta", appPackageName)
+	}
+
+	return printOutput(cmd.OutOrStdout(), &betaResult{
+		PackageName:       appPackageName,
+		HasTestingProgram: true,
+		Subscribed:        subscribe,
+	})
+}
+
+type betaResult struct {
+	PackageName       string `json:"packageName"`
+	HasTestingProgram bool   `json:"hasTestingProgram"`
+	Subscribed        bool   `json:"subscribed"`
+	FeedbackEmail     string `json:"feedbackEmail,omitempty"`
+}
+
+func (res *betaResult) header() []string {
+	return []string{"PACKAGE", "TESTING PROGRAM", "SUBSCRIBED", "FEEDBACK EMAIL"}
+}
+
+func (res *betaResult) rows() [][]string {
+	return [][]string{{res.PackageName, strconv.FormatBool(res.HasTestingProgram),
+		strconv.FormatBool(res.Subscribed), res.FeedbackEmail}}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/bundle"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Download only the base APK, as is
+const formatApk = "apk"
+
+// Nil if the format is the plain APK
+func parseDownloadFormat(format string) (*bundle.Format, error) {
+	if strings.ToLower(format) == formatApk {
+		return nil, nil
+	}
+
+	bundleFormat, err := bundle.ParseFormat(format)
+	if err != nil {
+		return nil, fmt.Errorf("unknown format %s, use apk, apks, xapk or zip", format)
+	}
+	return &bundleFormat, nil
+}
+
+/**
+Download the app with its splits and expansion files to a bundle archive
+
+The partially written bundle and the bundle failing verification are removed on error
+*/
+func downloadBundleToFile(gplay *playstore.Client, packageName string, versionCode int,
+	format bundle.Format, filePath string, verifier *apkVerifier) (size int64, sha256Sum []byte, err error) {
+	b, err := bundle.Fetch(gplay, packageName, versionCode)
+	if err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return
+	}
+
+	f, err := os.Create(filePath)
+	if err != nil {
+		return
+	}
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	err = b.Write(io.MultiWriter(f, hash, counter), format)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(filePath)
+		return
+	}
+
+	downloadInfo := &playstore.DownloadInfo{PackageName: packageName, VersionCode: b.VersionCode}
+	if err = verifier.verifyBundle(filePath, format, b, downloadInfo); err != nil {
+		_ = os.Remove(filePath)
+		return
+	}
+	return counter.size, hash.Sum(nil), nil
+}
+
+type countingWriter struct {
+	size int64
+}
+
+func (writer *countingWriter) Write(p []byte) (int, error) {
+	writer.size += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"github.com/spf13/cobra"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var detailsFields []string
+
+func init() {
+	detailsCmd.Flags().StringVar(&appPackageName, "id", "", "The app package name e.g., \"com.whatsapp\"")
+	_ = detailsCmd.MarkFlagRequired("id")
+	detailsCmd.Flags().StringSliceVar(&detailsFields, "field", nil,
+		"Print only these fields, e.g., --field versionCode. "+
+			"Field names are the JSON output names, a single field is printed as plain value in table output")
+
+	rootCmd.AddCommand(detailsCmd)
+}
+
+var detailsCmd = &cobra.Command{
+	Use:     "details",
+	Aliases: []string{"info"},
+	Short:   "Show app details",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		doc, err := gplay.GetDetails(appPackageName)
+		if err != nil {
+			return err
+		}
+
+		// The raw details are printed only as protobuf JSON
+		if outputFormat(output) == outputProtoJSON && len(detailsFields) == 0 {
+			return printOutput(cmd.OutOrStdout(), doc)
+		}
+
+		info := playstore.NewAppInfo(doc)
+		res := &detailsResult{
+			AppInfo:      info,
+			Changelog:    playstore.HtmlToText(info.RecentChangesHtml),
+			Availability: info.Restriction.String(),
+		}
+
+		if len(detailsFields) > 0 {
+			fields, err := selectFields(res, detailsFields)
+			if err != nil {
+				return err
+			}
+			return printOutput(cmd.OutOrStdout(), fields)
+		}
+		return printOutput(cmd.OutOrStdout(), res)
+	},
+}
+
+type detailsResult struct {
+	*playstore.AppInfo
+	// Recent changes as plain text
+	Changelog    string `json:"changelog"`
+	Availability string `json:"availability"`
+}
+
+func (res *detailsResult) header() []string {
+	return nil
+}
+
+func (res *detailsResult) rows() [][]string {
+	rows := [][]string{
+		{"Package:", res.PackageName},
+		{"Title:", res.Title},
+		{"Developer:", res.Developer},
+		{"Version:", fmt.Sprintf("%s (%d)", res.VersionString, res.VersionCode)},
+	}
+
+	if !res.UploadDate.IsZero() {
+		rows = append(rows, []string{"Updated:", res.UploadDate.Format("2006-01-02")})
+	}
+
+	rows = append(rows,
+		[]string{"Size:", formatSize(res.InstallationSize)},
+		[]string{"Installs:", strconv.FormatInt(res.Installs, 10) + "+"},
+		[]string{"Price:", formatPrice(res.Price)},
+		[]string{"Availability:", res.Availability},
+	)
+	if res.ContentRating != "" {
+		rows = append(rows, []string{"Content rating:", res.ContentRating})
+	}
+
+	rows = append(rows, []string{"Rating:", fmt.Sprintf("%.1f (%d ratings)", res.StarRating, res.RatingsCount)})
+	rows = append(rows, ratingHistogramRows(res.RatingHistogram)...)
+
+	rows = append(rows, listRows("Permissions:", res.Permissions)...)
+
+	dependencies := make([]string, len(res.Dependencies))
+	for i, dependency := range res.Dependencies {
+		dependencies[i] = dependency.PackageName
+		if dependency.VersionCode != 0 {
+			dependencies[i] += fmt.Sprintf(" (%d)", dependency.VersionCode)
+		}
+	}
+	rows = append(rows, listRows("Dependencies:", dependencies)...)
+
+	rows = append(rows, listRows("Changelog:", strings.Split(res.Changelog, "\n"))...)
+	return rows
+}
+
+// Histogram bars from five to one stars, scaled to the most common rating
+func ratingHistogramRows(histogram [5]uint64) [][]string {
+	const maxBarWidth = 30
+
+	var maxCount uint64
+	for _, count := range histogram {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	var rows [][]string
+	for stars := 5; stars >= 1; stars-- {
+		count := histogram[stars-1]
+		barWidth := 0
+		if maxCount > 0 {
+			barWidth = int(count * maxBarWidth / maxCount)
+		}
+		rows = append(rows, []string{"", fmt.Sprintf("%d★ %-*s %d",
+			stars, maxBarWidth, strings.Repeat("█", barWidth), count)})
+	}
+	return rows
+}
+
+// First value on the label row, the rest below it
+func listRows(label string, values []string) [][]string {
+	if len(values) == 0 || (len(values) == 1 && values[0] == "") {
+		return [][]string{{label, "-"}}
+	}
+
+	rows := make([][]string, len(values))
+	for i, value := range values {
+		rows[i] = []string{"", value}
+	}
+	rows[0][0] = label
+	return rows
+}
+
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func formatPrice(price playstore.Price) string {
+	if price.Micros == 0 {
+		return "free"
+	}
+	if price.Formatted != "" {
+		return price.Formatted
+	}
+	return fmt.Sprintf("%.2f %s", float64(price.Micros)/1e6, price.CurrencyCode)
+}
+
+// Fields of the selection, printed in the order they were selected
+type fieldSelection struct {
+	names  []string
+	values map[string]interface{}
+}
+
+func (fields *fieldSelection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fields.values)
+}
+
+func (fields *fieldSelection) header() []string {
+	return nil
+}
+
+func (fields *fieldSelection) rows() [][]string {
+	var rows [][]string
+	for _, name := range fields.names {
+		value := formatFieldValue(fields.values[name])
+		if len(fields.names) == 1 {
+			rows = append(rows, []string{value})
+		} else {
+			rows = append(rows, []string{name + ":", value})
+		}
+	}
+	return rows
+}
+
+func formatFieldValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = formatFieldValue(item)
+		}
+		return strings.Join(values, ",")
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	rawJSON, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(rawJSON)
+}
+
+// Select fields of `result` by their JSON names
+func selectFields(result interface{}, names []string) (*fieldSelection, error) {
+	rawJSON, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var allValues map[string]interface{}
+	if err = json.Unmarshal(rawJSON, &allValues); err != nil {
+		return nil, err
+	}
+
+	fields := &fieldSelection{names: names, values: map[string]interface{}{}}
+	for _, name := range names {
+		value, ok := allValues[name]
+		if !ok {
+			available := make([]string, 0, len(allValues))
+			for availableName := range allValues {
+				available = append(available, availableName)
+			}
+			sort.Strings(available)
+			return nil, fmt.Errorf("unknown field %s, available fields: %s", name, strings.Join(available, ", "))
+		}
+		fields.values[name] = value
+	}
+	return fields, nil
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(developerCmd)
+}
+
+var developerCmd = &cobra.Command{
+	Use:   "developer NAME_OR_ID",
+	Short: "List all apps published by a developer",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		apps, err := gplay.DeveloperApps(args[0])
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd.OutOrStdout(), appList(apps))
+	},
+}
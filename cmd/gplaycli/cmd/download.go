@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/cheggaaa/pb/v3"
 	log "github.com/sirupsen/logrus"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"strconv"
 )
 
 var (
@@ -15,6 +17,12 @@ var (
 	appVersionCode int
 	outApkName string
 	outDownloadDir string
+	manifestPath string
+	downloadWorkers int
+	verifyManifest bool
+	noTrust bool
+	trustWarnOnly bool
+	downloadFormat string
 )
 
 func init() {
@@ -23,6 +31,18 @@ func init() {
 		"App version code, latest if not specified")
 	downloadCmd.Flags().StringVar(&outApkName, "out", "", "Save APK as")
 	downloadCmd.Flags().StringVar(&outDownloadDir, "dir", "./", "Where to download files")
+	downloadCmd.Flags().StringVar(&manifestPath, "from", "",
+		"Download the apps listed in a file instead of --id. Plain text, CSV or YAML (by file extension)")
+	downloadCmd.Flags().IntVar(&downloadWorkers, "workers", 4,
+		"How many apps are downloaded concurrently when using --from")
+	downloadCmd.Flags().BoolVar(&verifyManifest, "verify-manifest", false,
+		"Check that the package name and version code in the APK manifest are the delivered ones")
+	downloadCmd.Flags().BoolVar(&noTrust, "no-trust", false,
+		"Do not pin or check the signing certificates")
+	downloadCmd.Flags().BoolVar(&trustWarnOnly, "trust-warn-only", false,
+		"Warn instead of failing, if the signing certificate differs from the pinned one")
+	downloadCmd.Flags().StringVar(&downloadFormat, "format", formatApk,
+		"apk downloads only the base APK. apks, xapk or zip bundle the splits, expansion files, icon and manifest.json")
 
 	rootCmd.AddCommand(downloadCmd)
 }
@@ -31,6 +51,20 @@ var downloadCmd = &cobra.Command{
 	Use: "download",
 	Short: "Download application apk",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if manifestPath != "" {
+			return runBatchDownload(cmd)
+		}
+
+		verifier, err := createApkVerifier()
+		if err != nil {
+			return err
+		}
+
+		format, err := parseDownloadFormat(downloadFormat)
+		if err != nil {
+			return err
+		}
+
 		gplay, err := createPlaystoreClient()
 		if err != nil {
 			return err
@@ -38,6 +72,24 @@ var downloadCmd = &cobra.Command{
 
 		log.Debugf("Download %s", appPackageName)
 
+		if format != nil {
+			if outApkName == "" {
+				outApkName = appPackageName + format.Extension()
+			}
+			filepath := path.Join(outDownloadDir, outApkName)
+
+			size, sha256, err := downloadBundleToFile(gplay, appPackageName, appVersionCode, *format, filepath, verifier)
+			if err != nil {
+				return err
+			}
+			return printOutput(cmd.OutOrStdout(), &downloadResult{
+				PackageName: appPackageName,
+				Path:        filepath,
+				Size:        size,
+				Sha256:      hex.EncodeToString(sha256),
+			})
+		}
+
 		reader, downloadInfo, err := gplay.Download(appPackageName, appVersionCode)
 		if err != nil {
 			return err
@@ -56,8 +108,75 @@ var downloadCmd = &cobra.Command{
 			return err
 		}
 		_, err = io.Copy(f, barReader)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
 
 		bar.Finish()
-		return err
+		if err != nil {
+			return err
+		}
+
+		if err = verifier.verify(filepath, downloadInfo); err != nil {
+			_ = os.Remove(filepath)
+			return err
+		}
+
+		return printOutput(cmd.OutOrStdout(), &downloadResult{
+			PackageName: appPackageName,
+			Path:        filepath,
+			Size:        downloadInfo.Size,
+			Sha256:      hex.EncodeToString(downloadInfo.Sha256),
+		})
 	},
 }
+
+type downloadResult struct {
+	PackageName string `json:"packageName"`
+	Path        string `json:"path"`
+	Size        int64  `json:"size"`
+	Sha256      string `json:"sha256"`
+}
+
+func (res *downloadResult) header() []string {
+	return []string{"PACKAGE", "PATH", "SIZE", "SHA256"}
+}
+
+func (res *downloadResult) rows() [][]string {
+	return [][]string{{res.PackageName, res.Path, strconv.FormatInt(res.Size, 10), res.Sha256}}
+}
+
+func runBatchDownload(cmd *cobra.Command) error {
+	entries, err := readManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	if err = checkManifestProfiles(entries); err != nil {
+		return err
+	}
+
+	verifier, err := createApkVerifier()
+	if err != nil {
+		return err
+	}
+
+	format, err := parseDownloadFormat(downloadFormat)
+	if err != nil {
+		return err
+	}
+	if err = checkManifestOutPaths(entries, format); err != nil {
+		return err
+	}
+
+	log.Infof("Downloading %d apps using %d workers", len(entries), downloadWorkers)
+
+	report := downloadBatch(entries, outDownloadDir, downloadWorkers, format, verifier)
+	if err = printOutput(cmd.OutOrStdout(), report); err != nil {
+		return err
+	}
+
+	if failed := report.failed(); failed > 0 {
+		return fmt.Errorf("%d of %d downloads failed", failed, len(report))
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/fdroid"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+var (
+	fdroidRepoDir     string
+	fdroidName        string
+	fdroidDescription string
+	fdroidAddress     string
+	fdroidLocale      string
+	fdroidKeyPath     string
+	fdroidCertPath    string
+	fdroidGenerateKey bool
+)
+
+func init() {
+	fdroidCmd.Flags().StringVar(&mirrorDir, "dir", "./mirror", "Mirror directory, see the mirror command")
+	fdroidCmd.Flags().StringVar(&fdroidRepoDir, "repo", "",
+		"Where to generate the repository, \"repo\" in the mirror directory if not specified")
+	fdroidCmd.Flags().StringVar(&fdroidName, "name", "gplay mirror", "Repository name")
+	fdroidCmd.Flags().StringVar(&fdroidDescription, "description", "", "Repository description")
+	fdroidCmd.Flags().StringVar(&fdroidAddress, "address", "",
+		"URL the repository directory is served from, e.g., \"https://example.org/fdroid/repo\"")
+	fdroidCmd.Flags().StringVar(&fdroidLocale, "locale", fdroid.DefaultLocale,
+		"Locale of the app details, the details are in the language of the playstore account")
+	fdroidCmd.Flags().StringVar(&fdroidKeyPath, "key", "",
+		"PEM encoded RSA private key used to sign the index")
+	fdroidCmd.Flags().StringVar(&fdroidCertPath, "cert", "",
+		"PEM encoded certificate of the key, read from the key file if not specified")
+	fdroidCmd.Flags().BoolVar(&fdroidGenerateKey, "generate-key", false,
+		"Create a new key and self-signed certificate to the key file, if the file does not exist")
+
+	_ = fdroidCmd.MarkFlagRequired("address")
+	_ = fdroidCmd.MarkFlagRequired("key")
+
+	rootCmd.AddCommand(fdroidCmd)
+}
+
+var fdroidCmd = &cobra.Command{
+	Use:   "fdroid",
+	Short: "Generate F-Droid repository from a mirror",
+	Long: "Generate F-Droid repository from a mirror, so devices can install the mirrored apps using an F-Droid client. " +
+		"Versions with split APKs are skipped",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		signer, err := loadRepoSigner()
+		if err != nil {
+			return err
+		}
+
+		repoDir := fdroidRepoDir
+		if repoDir == "" {
+			repoDir = filepath.Join(mirrorDir, "repo")
+		}
+
+		repo, err := fdroid.GenerateRepo(mirrorDir, repoDir, &fdroid.Config{
+			Name:        fdroidName,
+			Description: fdroidDescription,
+			Address:     fdroidAddress,
+			Locale:      fdroidLocale,
+			Signer:      signer,
+		})
+		if err != nil {
+			return err
+		}
+		return printOutput(cmd.OutOrStdout(), &fdroidResult{repo})
+	},
+}
+
+func loadRepoSigner() (*fdroid.Signer, error) {
+	if _, err := os.Stat(fdroidKeyPath); os.IsNotExist(err) && fdroidGenerateKey {
+		log.Infof("Generating repository key to %s", fdroidKeyPath)
+
+		signer, err := fdroid.GenerateSigner(fdroidName)
+		if err != nil {
+			return nil, err
+		}
+		if err = ioutil.WriteFile(fdroidKeyPath, signer.EncodePEM(), 0600); err != nil {
+			return nil, err
+		}
+		return signer, nil
+	}
+
+	signer, err := fdroid.LoadSigner(fdroidKeyPath, fdroidCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not load repository key: %v", err)
+	}
+	return signer, nil
+}
+
+type fdroidResult struct {
+	*fdroid.Repo
+}
+
+func (res *fdroidResult) header() []string {
+	return nil
+}
+
+func (res *fdroidResult) rows() [][]string {
+	rows := [][]string{
+		{"Repository:", res.Dir},
+		{"Fingerprint:", res.Fingerprint},
+		{"Apps:", strconv.Itoa(res.Apps)},
+		{"Versions:", strconv.Itoa(res.Versions)},
+	}
+
+	skipped := make([]string, len(res.Skipped))
+	for i, version := range res.Skipped {
+		skipped[i] = fmt.Sprintf("%s (%d): %s", version.PackageName, version.VersionCode, version.Reason)
+	}
+	return append(rows, listRows("Skipped:", skipped)...)
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/adb"
+	"github.com/jarijaas/go-gplayapi/pkg/bundle"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	installSerial           string
+	adbServerAddr           string
+	installDowngrade        bool
+	installGrantPermissions bool
+)
+
+func init() {
+	installCmd.Flags().StringVar(&appPackageName, "id", "", "The app package name e.g., \"com.whatsapp\"")
+	installCmd.Flags().IntVar(&appVersionCode, "version", 0, "App version code, latest if not specified")
+	installCmd.Flags().StringVar(&installSerial, "serial", "",
+		"Serial of the device, alternatively set env var ANDROID_SERIAL. Not needed if only one device is connected")
+	installCmd.Flags().StringVar(&adbServerAddr, "adb", adb.DefaultServerAddr, "Address of the adb server")
+	installCmd.Flags().BoolVar(&installDowngrade, "downgrade", false, "Allow installing an older version")
+	installCmd.Flags().BoolVar(&installGrantPermissions, "grant-permissions", false,
+		"Grant all the runtime permissions")
+	installCmd.Flags().BoolVar(&noTrust, "no-trust", false, "Do not pin or check the signing certificates")
+	installCmd.Flags().BoolVar(&trustWarnOnly, "trust-warn-only", false,
+		"Warn instead of failing, if the signing certificate differs from the pinned one")
+
+	rootCmd.AddCommand(installCmd)
+}
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Download an app and install it to a connected device",
+	Long: "Download the base and split APKs of an app and install them to a device through the adb server, " +
+		"like adb install-multiple. Use --device-spec to download only the splits the device needs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appPackageName == "" {
+			return fmt.Errorf("specify the app with --id")
+		}
+
+		adbClient := adb.NewClient(adbServerAddr)
+
+		serial, err := selectDevice(adbClient, installSerial)
+		if err != nil {
+			return err
+		}
+
+		verifier, err := createApkVerifier()
+		if err != nil {
+			return err
+		}
+
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		b, err := bundle.Fetch(gplay, appPackageName, appVersionCode)
+		if err != nil {
+			return err
+		}
+
+		tmpDir, err := ioutil.TempDir("", "gplay-install")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+
+		var paths []string
+		for _, apk := range b.Apks {
+			log.Infof("Downloading %s", apk.FileName())
+
+			apkPath := filepath.Join(tmpDir, apk.FileName())
+			if err = downloadDeliveryFile(apk, apkPath); err != nil {
+				return err
+			}
+			paths = append(paths, apkPath)
+		}
+
+		downloadInfo := &playstore.DownloadInfo{PackageName: appPackageName, VersionCode: b.VersionCode}
+		if err = verifier.verify(paths[0], downloadInfo); err != nil {
+			return err
+		}
+
+		var installArgs []string
+		if installDowngrade {
+			installArgs = append(installArgs, "-d")
+		}
+		if installGrantPermissions {
+			installArgs = append(installArgs, "-g")
+		}
+
+		log.Infof("Installing %s (%d APKs) to %s", appPackageName, len(paths), serial)
+		if err = adbClient.InstallFiles(serial, paths, installArgs...); err != nil {
+			return err
+		}
+
+		res := &installResult{PackageName: appPackageName, VersionCode: b.VersionCode, Serial: serial}
+		for _, apk := range b.Apks {
+			res.Apks = append(res.Apks, apk.FileName())
+		}
+		return printOutput(cmd.OutOrStdout(), res)
+	},
+}
+
+/**
+The device with the serial, the serial from ANDROID_SERIAL or the only connected device
+*/
+func selectDevice(adbClient *adb.Client, serial string) (string, error) {
+	if serial == "" {
+		serial = os.Getenv("ANDROID_SERIAL")
+	}
+
+	devices, err := adbClient.Devices()
+	if err != nil {
+		return "", err
+	}
+
+	var ready []string
+	for _, device := range devices {
+		if serial != "" && device.Serial == serial && device.State != "device" {
+			return "", fmt.Errorf("device %s is %s", serial, device.State)
+		}
+		if device.State == "device" {
+			ready = append(ready, device.Serial)
+		}
+	}
+
+	switch {
+	case serial != "":
+		for _, readySerial := range ready {
+			if readySerial == serial {
+				return serial, nil
+			}
+		}
+		return "", fmt.Errorf("device %s is not connected", serial)
+	case len(ready) == 1:
+		return ready[0], nil
+	case len(ready) == 0:
+		return "", fmt.Errorf("no devices connected")
+	}
+	return "", fmt.Errorf("%d devices connected, select one with --serial: %s", len(ready), strings.Join(ready, ", "))
+}
+
+func downloadDeliveryFile(file playstore.DeliveryFile, filePath string) error {
+	reader, err := file.Download()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	f, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not download %s: %v", file.FileName(), err)
+	}
+	return nil
+}
+
+type installResult struct {
+	PackageName string   `json:"packageName"`
+	VersionCode int      `json:"versionCode"`
+	Serial      string   `json:"serial"`
+	Apks        []string `json:"apks"`
+}
+
+func (res *installResult) header() []string {
+	return nil
+}
+
+func (res *installResult) rows() [][]string {
+	rows := [][]string{
+		{"Package:", res.PackageName},
+		{"Version code:", strconv.Itoa(res.VersionCode)},
+		{"Device:", res.Serial},
+	}
+	return append(rows, listRows("APKs:", res.Apks)...)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	libraryCmd.AddCommand(libraryListCmd)
+	libraryCmd.AddCommand(libraryAddCmd)
+	libraryCmd.AddCommand(libraryRemoveCmd)
+
+	rootCmd.AddCommand(libraryCmd)
+}
+
+var libraryCmd = &cobra.Command{
+	Use:   "library",
+	Short: "Manage the apps the account owns",
+}
+
+var libraryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the apps in the account library",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		packageNames, err := gplay.GetLibraryPackageNames()
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd.OutOrStdout(), libraryResult(packageNames))
+	},
+}
+
+var libraryAddCmd = &cobra.Command{
+	Use:   "add PACKAGE...",
+	Short: "Add apps to the account library",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+		if err = gplay.ModifyLibrary(args, nil); err != nil {
+			return err
+		}
+		return printOutput(cmd.OutOrStdout(), &libraryModificationResult{Added: args})
+	},
+}
+
+var libraryRemoveCmd = &cobra.Command{
+	Use:   "remove PACKAGE...",
+	Short: "Remove apps from the account library",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+		if err = gplay.ModifyLibrary(nil, args); err != nil {
+			return err
+		}
+		return printOutput(cmd.OutOrStdout(), &libraryModificationResult{Removed: args})
+	},
+}
+
+// Package names of the apps in the library
+type libraryResult []string
+
+func (res libraryResult) header() []string {
+	return []string{"PACKAGE"}
+}
+
+func (res libraryResult) rows() [][]string {
+	rows := make([][]string, len(res))
+	for i, packageName := range res {
+		rows[i] = []string{packageName}
+	}
+	return rows
+}
+
+type libraryModificationResult struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+func (res *libraryModificationResult) header() []string {
+	return []string{"PACKAGE", "CHANGE"}
+}
+
+func (res *libraryModificationResult) rows() [][]string {
+	var rows [][]string
+	for _, packageName := range res.Added {
+		rows = append(rows, []string{packageName, "added"})
+	}
+	for _, packageName := range res.Removed {
+		rows = append(rows, []string{packageName, "removed"})
+	}
+	return rows
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
@@ -24,8 +23,26 @@ var loginCmd = &cobra.Command{
 			return err
 		}
 
-		log.Infof("GPLAY_GSFID=%s", auth.GetGsfId())
-		log.Infof("GPLAY_AUTHSUB=%s", auth.GetAuthSubToken())
-		return nil
+		return printOutput(cmd.OutOrStdout(), &loginResult{
+			GsfId:        auth.GetGsfId(),
+			AuthSubToken: auth.GetAuthSubToken(),
+		})
 	},
+}
+
+type loginResult struct {
+	GsfId        string `json:"gsfId"`
+	AuthSubToken string `json:"authSubToken"`
+}
+
+func (res *loginResult) header() []string {
+	return nil
+}
+
+// Can be evaluated in shell to set the env vars
+func (res *loginResult) rows() [][]string {
+	return [][]string{
+		{"GPLAY_GSFID=" + res.GsfId},
+		{"GPLAY_AUTHSUB=" + res.AuthSubToken},
+	}
 }
\ No newline at end of file
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"strconv"
+	"strings"
+)
+
+var (
+	mirrorDir          string
+	mirrorWatchList    string
+	mirrorKeepVersions int
+)
+
+func init() {
+	mirrorCmd.Flags().StringVar(&mirrorDir, "dir", "./mirror", "Mirror directory")
+	mirrorCmd.Flags().StringVar(&mirrorWatchList, "from", "",
+		"File listing the mirrored apps. Plain text, CSV or YAML (by file extension), version codes are ignored")
+	mirrorCmd.Flags().IntVar(&mirrorKeepVersions, "keep", 2,
+		"How many previous versions of each app are kept in addition to the latest")
+
+	rootCmd.AddCommand(mirrorCmd)
+}
+
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror [PACKAGE...]",
+	Short: "Download new versions of the watched apps to a local mirror",
+	Long: "Download new versions of the watched apps to a local mirror. " +
+		"Only the changed apps are downloaded, so the command can be re-run e.g., periodically",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		packageNames := args
+		if mirrorWatchList != "" {
+			entries, err := readManifest(mirrorWatchList)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				packageNames = append(packageNames, entry.PackageName)
+			}
+		}
+
+		if len(packageNames) == 0 {
+			return fmt.Errorf("no apps to mirror, specify packages or --from")
+		}
+		if mirrorKeepVersions < 0 {
+			return fmt.Errorf("--keep cannot be negative")
+		}
+
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		log.Infof("Mirroring %d apps to %s", len(packageNames), mirrorDir)
+
+		updates, err := playstore.NewMirror(gplay, mirrorDir, mirrorKeepVersions).Update(packageNames)
+		if err != nil {
+			return err
+		}
+
+		res := mirrorResult(updates)
+		if err = printOutput(cmd.OutOrStdout(), res); err != nil {
+			return err
+		}
+
+		if failed := res.failed(); failed > 0 {
+			return fmt.Errorf("%d of %d apps could not be mirrored", failed, len(res))
+		}
+		return nil
+	},
+}
+
+type mirrorResult []playstore.MirrorUpdate
+
+func (res mirrorResult) header() []string {
+	return []string{"PACKAGE", "STATUS", "OLD VERSION", "NEW VERSION", "PRUNED / ERROR"}
+}
+
+func (res mirrorResult) rows() [][]string {
+	rows := make([][]string, len(res))
+	for i, update := range res {
+		details := update.Error
+		if update.Status != playstore.MirrorFailed {
+			pruned := make([]string, len(update.Pruned))
+			for j, versionCode := range update.Pruned {
+				pruned[j] = strconv.Itoa(versionCode)
+			}
+			details = strings.Join(pruned, ",")
+		}
+		rows[i] = []string{update.PackageName, string(update.Status),
+			formatVersionCode(update.OldVersionCode), formatVersionCode(update.NewVersionCode), details}
+	}
+	return rows
+}
+
+func (res mirrorResult) failed() int {
+	failed := 0
+	for _, update := range res {
+		if update.Status == playstore.MirrorFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func formatVersionCode(versionCode int) string {
+	if versionCode == 0 {
+		return "-"
+	}
+	return strconv.Itoa(versionCode)
+}
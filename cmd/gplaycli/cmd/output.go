@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v2"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+type outputFormat string
+
+const (
+	outputJSON      outputFormat = "json"
+	outputYAML      outputFormat = "yaml"
+	outputTable     outputFormat = "table"
+	outputProtoJSON outputFormat = "protojson"
+)
+
+var outputFormats = []outputFormat{outputTable, outputJSON, outputYAML, outputProtoJSON}
+
+// Implemented by command results that can be printed as a table
+type tableOutput interface {
+	// Column names, no header row is printed if nil
+	header() []string
+	rows() [][]string
+}
+
+func validateOutputFormat() error {
+	for _, format := range outputFormats {
+		if outputFormat(output) == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown output format %s, use one of: %v", output, outputFormats)
+}
+
+/**
+Print command result to `w` in the selected output format
+
+Only results implementing tableOutput can be printed as table.
+protojson prints protobuf messages (or slices of them) in the canonical protobuf JSON form,
+other results are printed as JSON
+*/
+func printOutput(w io.Writer, result interface{}) error {
+	switch outputFormat(output) {
+	case outputTable:
+		table, ok := result.(tableOutput)
+		if !ok {
+			return fmt.Errorf("result cannot be printed as table, use other output format")
+		}
+		return printTable(w, table)
+	case outputYAML:
+		// Round trip through JSON, so the field names are the same as in the JSON output
+		rawJSON, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+
+		var value interface{}
+		if err = yaml.Unmarshal(rawJSON, &value); err != nil {
+			return err
+		}
+
+		rawYAML, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(rawYAML)
+		return err
+	case outputProtoJSON:
+		if rawJSON, ok, err := marshalProtoJSON(result); ok {
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(w, string(rawJSON))
+			return err
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// `ok` is false if `result` is not a protobuf message or a slice of protobuf messages
+func marshalProtoJSON(result interface{}) (rawJSON []byte, ok bool, err error) {
+	marshaler := protojson.MarshalOptions{Multiline: true, Indent: "  "}
+
+	if msg, isMsg := result.(proto.Message); isMsg {
+		rawJSON, err = marshaler.Marshal(proto.MessageV2(msg))
+		return rawJSON, true, err
+	}
+
+	value := reflect.ValueOf(result)
+	if value.Kind() != reflect.Slice {
+		return nil, false, nil
+	}
+
+	items := make([]string, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		msg, isMsg := value.Index(i).Interface().(proto.Message)
+		if !isMsg {
+			return nil, false, nil
+		}
+
+		rawItem, err := marshaler.Marshal(proto.MessageV2(msg))
+		if err != nil {
+			return nil, true, err
+		}
+		items[i] = string(rawItem)
+	}
+	return []byte("[" + strings.Join(items, ",\n") + "]"), true, nil
+}
+
+func printTable(w io.Writer, table tableOutput) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	if header := table.header(); header != nil {
+		if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range table.rows() {
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// App documents, e.g., search or list results
+type appList []*pb.DocV2
+
+func (apps appList) header() []string {
+	return []string{"PACKAGE", "TITLE", "DEVELOPER", "VERSION CODE"}
+}
+
+func (apps appList) rows() [][]string {
+	rows := make([][]string, len(apps))
+	for i, app := range apps {
+		rows[i] = []string{app.GetDocid(), app.GetTitle(), app.GetCreator(),
+			strconv.Itoa(int(app.GetDetails().GetAppDetails().GetVersionCode()))}
+	}
+	return rows
+}
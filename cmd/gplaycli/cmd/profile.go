@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"bytes"
+	"github.com/jarijaas/go-gplayapi/pkg/adb"
+	"github.com/jarijaas/go-gplayapi/pkg/auth"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+	"strconv"
+)
+
+var (
+	profileGetpropPath   string
+	profileFeaturesPath  string
+	profileLibrariesPath string
+	profileConfigPath    string
+	profileSerial        string
+	profileOutPath       string
+)
+
+func init() {
+	profileImportCmd.Flags().StringVar(&profileGetpropPath, "getprop", "",
+		"Output of \"adb shell getprop\". The connected device is read, if not specified")
+	profileImportCmd.Flags().StringVar(&profileFeaturesPath, "features", "", "Output of \"adb shell pm list features\"")
+	profileImportCmd.Flags().StringVar(&profileLibrariesPath, "libraries", "",
+		"Output of \"adb shell pm list libraries\"")
+	profileImportCmd.Flags().StringVar(&profileConfigPath, "config", "",
+		"Dumped DeviceConfigurationProto as JSON or binary, replaces the configuration read from the other files")
+	profileImportCmd.Flags().StringVar(&profileSerial, "serial", "",
+		"Serial of the device to read, alternatively set env var ANDROID_SERIAL")
+	profileImportCmd.Flags().StringVar(&adbServerAddr, "adb", adb.DefaultServerAddr, "Address of the adb server")
+	profileImportCmd.Flags().StringVar(&profileOutPath, "out", "device-profile.json", "File the profile is saved to")
+
+	profileCmd.AddCommand(profileImportCmd)
+
+	rootCmd.AddCommand(profileCmd)
+}
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage the device profiles used in checkin",
+	Long: "The device profile is sent in checkin, the playstore filters apps by it. " +
+		"Use a profile with --device-profile",
+}
+
+var profileImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Create a device profile from the properties of a real device",
+	Long: "Create a device profile from getprop and \"pm list\" outputs, either from files or read from a device " +
+		"through the adb server. Values missing from the outputs, e.g., GL extensions, are kept from the default profile",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var getprop, features, libraries []byte
+		var err error
+
+		if profileGetpropPath != "" {
+			if getprop, err = ioutil.ReadFile(profileGetpropPath); err != nil {
+				return err
+			}
+			if features, err = readOptionalFile(profileFeaturesPath); err != nil {
+				return err
+			}
+			if libraries, err = readOptionalFile(profileLibrariesPath); err != nil {
+				return err
+			}
+		} else if profileConfigPath == "" {
+			if getprop, features, libraries, err = readDeviceProperties(); err != nil {
+				return err
+			}
+		}
+
+		props, err := auth.ParseGetprop(bytes.NewReader(getprop))
+		if err != nil {
+			return err
+		}
+		featureList, err := auth.ParsePackageManagerList(bytes.NewReader(features))
+		if err != nil {
+			return err
+		}
+		libraryList, err := auth.ParsePackageManagerList(bytes.NewReader(libraries))
+		if err != nil {
+			return err
+		}
+
+		profile := auth.ImportDeviceProfile(props, featureList, libraryList)
+
+		if profileConfigPath != "" {
+			data, err := ioutil.ReadFile(profileConfigPath)
+			if err != nil {
+				return err
+			}
+			if profile.Configuration, err = auth.ParseDeviceConfiguration(data); err != nil {
+				return err
+			}
+		}
+
+		if err = profile.Save(profileOutPath); err != nil {
+			return err
+		}
+		log.Infof("Device profile saved to %s, use it with --device-profile %s --force-login",
+			profileOutPath, profileOutPath)
+
+		return printOutput(cmd.OutOrStdout(), &profileImportResult{
+			Path:          profileOutPath,
+			Fingerprint:   profile.Build.GetId(),
+			Model:         profile.Build.GetModel(),
+			SdkVersion:    int(profile.Build.GetSdkVersion()),
+			ScreenDensity: int(profile.Configuration.GetScreenDensity()),
+			Abis:          profile.Configuration.GetNativePlatform(),
+			Locale:        profile.Locale,
+			Features:      len(profile.Configuration.GetSystemAvailableFeature()),
+			Libraries:     len(profile.Configuration.GetSystemSharedLibrary()),
+		})
+	},
+}
+
+func readOptionalFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return ioutil.ReadFile(path)
+}
+
+// Outputs of getprop, "pm list features" and "pm list libraries" of the connected device
+func readDeviceProperties() (getprop []byte, features []byte, libraries []byte, err error) {
+	adbClient := adb.NewClient(adbServerAddr)
+
+	serial, err := selectDevice(adbClient, profileSerial)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	log.Infof("Reading device properties of %s", serial)
+
+	if getprop, err = adbClient.Exec(serial, "getprop", nil); err != nil {
+		return nil, nil, nil, err
+	}
+	if features, err = adbClient.Exec(serial, "pm list features", nil); err != nil {
+		return nil, nil, nil, err
+	}
+	if libraries, err = adbClient.Exec(serial, "pm list libraries", nil); err != nil {
+		return nil, nil, nil, err
+	}
+	return getprop, features, libraries, nil
+}
+
+type profileImportResult struct {
+	Path          string   `json:"path"`
+	Fingerprint   string   `json:"fingerprint"`
+	Model         string   `json:"model"`
+	SdkVersion    int      `json:"sdkVersion"`
+	ScreenDensity int      `json:"screenDensity"`
+	Abis          []string `json:"abis"`
+	Locale        string   `json:"locale"`
+	Features      int      `json:"features"`
+	Libraries     int      `json:"libraries"`
+}
+
+func (res *profileImportResult) header() []string {
+	return nil
+}
+
+func (res *profileImportResult) rows() [][]string {
+	rows := [][]string{
+		{"File:", res.Path},
+		{"Fingerprint:", res.Fingerprint},
+		{"Model:", res.Model},
+		{"SDK version:", strconv.Itoa(res.SdkVersion)},
+		{"Screen density:", strconv.Itoa(res.ScreenDensity)},
+		{"Locale:", res.Locale},
+		{"Features:", strconv.Itoa(res.Features)},
+		{"Libraries:", strconv.Itoa(res.Libraries)},
+	}
+	return append(rows, listRows("ABIs:", res.Abis)...)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	relatedCmd.Flags().StringVar(&appPackageName, "id", "", "The app package name e.g., \"com.whatsapp\"")
+	_ = relatedCmd.MarkFlagRequired("id")
+
+	rootCmd.AddCommand(relatedCmd)
+}
+
+var relatedCmd = &cobra.Command{
+	Use:   "related",
+	Short: "List apps related to an app, e.g., similar apps and more by the developer",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		lists, err := gplay.GetRelatedLists(appPackageName)
+		if err != nil {
+			return err
+		}
+
+		var res relatedResult
+		for _, list := range lists {
+			apps, err := gplay.GetAppList(list.Url)
+			if err != nil {
+				return err
+			}
+			res = append(res, relatedList{Label: list.Label, Url: list.Url, Apps: apps})
+		}
+		return printOutput(cmd.OutOrStdout(), res)
+	},
+}
+
+type relatedList struct {
+	Label string  `json:"label"`
+	Url   string  `json:"url"`
+	Apps  appList `json:"apps"`
+}
+
+type relatedResult []relatedList
+
+func (res relatedResult) header() []string {
+	return append([]string{"LIST"}, appList{}.header()...)
+}
+
+func (res relatedResult) rows() [][]string {
+	var rows [][]string
+	for _, list := range res {
+		for _, row := range list.Apps.rows() {
+			rows = append(rows, append([]string{list.Label}, row...))
+		}
+	}
+	return rows
+}
@@ -3,11 +3,16 @@ package cmd
 import (
 	"fmt"
 	"github.com/jarijaas/go-gplayapi/pkg/auth"
+	"github.com/jarijaas/go-gplayapi/pkg/keyring"
 	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
 	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -17,18 +22,42 @@ var (
 	authSub string
 	forceLogin bool
 	verbose bool
+	output string
+	cacheDir string
+	cacheTTL time.Duration
+	noCache bool
+	trustStorePath string
+	deviceSpecPath string
+	deviceProfilePath string
+	accountProfile string
+	tokenStoreType string
+	tokenFilePath string
+	tokenKeyPath string
+	tokenRecipientsPath string
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "gplay",
 	Short: "Client for Google Playstore, can download apps",
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		if verbose {
 			log.SetLevel(log.DebugLevel)
 		}
+		return validateOutputFormat()
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		for _, gplay := range createdClients {
+			logCacheStats(gplay.CacheStats())
+		}
 	},
 }
 
+// Clients created by the command, their cache usage is logged when the command finishes
+var (
+	createdClients      []*playstore.Client
+	createdClientsMutex sync.Mutex
+)
+
 func Execute() {
 	rootCmd.PersistentFlags().StringVar(&email, "email", "", "")
 	rootCmd.PersistentFlags().StringVar(&password, "password", "", "")
@@ -40,6 +69,34 @@ func Execute() {
 		"Authenticate, even if current gsfId and authSubToken are valid")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false,
 		"Enable debug messages")
+	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", string(outputTable),
+		"Output format: table, json, yaml or protojson. Logs are written to stderr")
+	rootCmd.PersistentFlags().StringVar(&cacheDir, "cache-dir", "",
+		"Cache details, search and list responses to this directory, in memory only if not specified")
+	rootCmd.PersistentFlags().DurationVar(&cacheTTL, "cache-ttl", 5*time.Minute,
+		"How long responses are cached, if the server does not tell")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Do not cache responses")
+	rootCmd.PersistentFlags().StringVar(&trustStorePath, "trust-store", defaultTrustStorePath(),
+		"File the pinned signing certificates are saved to")
+	rootCmd.PersistentFlags().StringVar(&deviceSpecPath, "device-spec", "",
+		"Download only the splits matching the device. bundletool device-spec.json or DeviceConfigurationProto as JSON")
+	rootCmd.PersistentFlags().StringVar(&deviceProfilePath, "device-profile", "",
+		"Check in as the device of this profile, see \"gplay profile import\". Use with --force-login to check in again")
+
+	rootCmd.PersistentFlags().StringVar(&accountProfile, "profile", "",
+		"Use the tokens, email, device profile and locale of this account profile, see \"gplay accounts\". " +
+			"The default profile if not specified")
+
+	rootCmd.PersistentFlags().StringVar(&tokenStoreType, "token-store", "",
+		"Where the tokens are saved: keyring, file, env or memory. Alternatively, set env var GPLAY_TOKEN_STORE " +
+			"(default keyring)")
+	rootCmd.PersistentFlags().StringVar(&tokenFilePath, "token-file", defaultTokenFilePath(),
+		"Encrypted token file of the file token store")
+	rootCmd.PersistentFlags().StringVar(&tokenKeyPath, "token-key", "",
+		"Key file of the token file, an age identity from \"age-keygen\" or, e.g., random bytes from \"openssl rand\". " +
+			"The passphrase is read from env var GPLAY_TOKEN_PASSPHRASE or asked if not specified")
+	rootCmd.PersistentFlags().StringVar(&tokenRecipientsPath, "token-recipients", "",
+		"age recipients file, the token file is also encrypted to these public keys. Needs an age identity as --token-key")
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal(err)
@@ -47,28 +104,131 @@ func Execute() {
 }
 
 func createPlaystoreClient() (*playstore.Client, error) {
-	// Check env variables, if cli arguments were not set
-	if gsfId == "" {
-		gsfId = os.Getenv("GPLAY_GSFID")
+	return createProfilePlaystoreClient("")
+}
+
+/**
+Create playstore client using a named account profile, the --profile or the default profile if `name` is empty.
+The flags override the values of the profile
+*/
+func createProfilePlaystoreClient(name string) (*playstore.Client, error) {
+	return createDevicePlaystoreClient(name, "")
+}
+
+/**
+Create playstore client using a named account profile, like `createProfilePlaystoreClient`,
+that checks in as the device of the device profile in `devicePath` if not empty.
+The tokens of the account are used with the GsfId of the device, the GsfId is not saved
+*/
+func createDevicePlaystoreClient(name string, devicePath string) (*playstore.Client, error) {
+	// The token flags are for the profile of the command, not for the profiles named, e.g., in a batch manifest
+	tokenGsfId, tokenAuthSub := "", ""
+	if name == "" {
+		name = accountProfile
+		tokenGsfId, tokenAuthSub = gsfId, authSub
 	}
-	if authSub == "" {
-		authSub = os.Getenv("GPLAY_AUTHSUB")
+	// Named profiles read their own env variables, e.g., GPLAY_FI_GSFID, through the env token store
+	useEnvTokens := name == ""
+
+	store, err := getTokenStore()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := keyring.GetProfile(store, name)
+	if err != nil {
+		if name != "" {
+			return nil, err
+		}
+		// E.g., the keyring is not available on a headless server
+		log.Debugf("Could not read the default profile: %v", err)
+	}
+	if profile == nil {
+		profile = &keyring.Profile{}
+	}
+
+	// Check env variables, if cli arguments were not set
+	if useEnvTokens {
+		if tokenGsfId == "" {
+			tokenGsfId = os.Getenv("GPLAY_GSFID")
+		}
+		if tokenAuthSub == "" {
+			tokenAuthSub = os.Getenv("GPLAY_AUTHSUB")
+		}
 	}
 
 	authCfg := &auth.Config{
 		Email:        email,
 		Password:     password,
-		GsfId:        gsfId,
-		AuthSubToken: authSub,
+		GsfId:        tokenGsfId,
+		AuthSubToken: tokenAuthSub,
+		Profile:      profile.Name,
+		TokenStore:   store,
+	}
+	if authCfg.Email == "" {
+		authCfg.Email = profile.Email
+	}
+
+	profilePath := devicePath
+	if profilePath == "" {
+		profilePath = deviceProfilePath
+	}
+	if profilePath == "" {
+		profilePath = profile.DeviceProfile
+	}
+	if profilePath != "" {
+		deviceProfile, err := auth.LoadDeviceProfile(profilePath)
+		if err != nil {
+			return nil, err
+		}
+		authCfg.DeviceProfile = deviceProfile
+	}
+
+	if profile.Locale != "" {
+		if authCfg.DeviceProfile == nil {
+			authCfg.DeviceProfile = auth.DefaultDeviceProfile()
+		}
+		// Checkin uses underscore, e.g., "fi_FI"
+		authCfg.DeviceProfile.Locale = strings.Replace(profile.Locale, "-", "_", -1)
+	}
+
+	cache, err := createResponseCache()
+	if err != nil {
+		return nil, err
+	}
+
+	var deviceSpec *playstore.DeviceSpec
+	if deviceSpecPath != "" {
+		if deviceSpec, err = playstore.LoadDeviceSpec(deviceSpecPath); err != nil {
+			return nil, err
+		}
 	}
 
 	gplay, err := playstore.CreatePlaystoreClient(&playstore.Config{
 		AuthConfig: authCfg,
+		Cache:      cache,
+		CacheTTL:   cacheTTL,
+		DeviceSpec: deviceSpec,
+		Locale:     profile.Locale,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	gplay.OnNotification(logNotification)
+
+	createdClientsMutex.Lock()
+	createdClients = append(createdClients, gplay)
+	createdClientsMutex.Unlock()
+
+	// Without tokens, the login checks in as the device
+	if devicePath != "" && gplay.GetAuthClient().HasAuthToken() {
+		log.Debugf("Check in as the device of %s", devicePath)
+		if err = gplay.GetAuthClient().CheckinDevice(); err != nil {
+			return nil, err
+		}
+	}
+
 	// Force reauthentication by removing current tokens
 	// Ask for creds if not authenticated
 	if forceLogin || !gplay.IsValidAuthToken() {
@@ -98,4 +258,39 @@ func createPlaystoreClient() (*playstore.Client, error) {
 			"Current gsfId and authSubToke are valid. To force reauthentication, use --force-login flag")
 	}
 	return gplay, err
-}
\ No newline at end of file
+}
+
+func logNotification(notification *pb.Notification) {
+	packageName := playstore.NotificationPackageName(notification)
+
+	switch {
+	case playstore.IsMaliciousRemoval(notification):
+		log.Warnf("The playstore removed %s from the account for being malicious", packageName)
+	case notification.GetPurchaseRemovalData() != nil:
+		log.Warnf("The playstore removed %s from the account", packageName)
+	case notification.GetLibraryUpdate() != nil:
+		log.Debugf("Library of the account was updated")
+	default:
+		log.Debugf("Notification about %s: %s", packageName, notification.GetNotificationId())
+	}
+}
+
+func logCacheStats(stats playstore.CacheStats) {
+	if stats.Hits+stats.Misses+stats.Prefetched == 0 {
+		return
+	}
+	log.Debugf("Cache hits: %d of %d (%.0f%%), %d revalidated",
+		stats.Hits, stats.Hits+stats.Misses, stats.HitRate()*100, stats.Revalidated)
+	log.Debugf("Prefetched responses used: %d of %d (%.0f%%)",
+		stats.PrefetchHits, stats.Prefetched, stats.PrefetchHitRate()*100)
+}
+
+func createResponseCache() (playstore.ResponseCache, error) {
+	if noCache {
+		return nil, nil
+	}
+	if cacheDir != "" {
+		return playstore.NewDiskCache(cacheDir)
+	}
+	return playstore.NewMemoryCache(playstore.DefaultMemoryCacheSize), nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/keyring"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh/terminal"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Token store types of --token-store
+const (
+	tokenStoreKeyring = "keyring"
+	tokenStoreFile    = "file"
+	tokenStoreEnv     = "env"
+	tokenStoreMemory  = "memory"
+)
+
+// Created on first use and shared by the clients, so the passphrase is asked once and memory tokens are shared
+var (
+	tokenStore      keyring.TokenStore
+	tokenStoreMutex sync.Mutex
+)
+
+func defaultTokenFilePath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "gplay-tokens.json"
+	}
+	return filepath.Join(configDir, "gplay", "tokens.json")
+}
+
+/**
+Token store selected with --token-store or GPLAY_TOKEN_STORE. The token file is encrypted with the --token-key,
+an age identity or any other key file. Otherwise the passphrase is read from GPLAY_TOKEN_PASSPHRASE or asked
+*/
+func getTokenStore() (keyring.TokenStore, error) {
+	tokenStoreMutex.Lock()
+	defer tokenStoreMutex.Unlock()
+
+	if tokenStore != nil {
+		return tokenStore, nil
+	}
+
+	storeType := tokenStoreType
+	if storeType == "" {
+		storeType = os.Getenv("GPLAY_TOKEN_STORE")
+	}
+
+	var err error
+	switch storeType {
+	case "", tokenStoreKeyring:
+		tokenStore = keyring.NewOSKeyring()
+	case tokenStoreEnv:
+		tokenStore = keyring.EnvStore{}
+	case tokenStoreMemory:
+		tokenStore = keyring.NewMemoryStore()
+	case tokenStoreFile:
+		tokenStore, err = createFileTokenStore()
+	default:
+		return nil, fmt.Errorf("unknown token store %s, use keyring, file, env or memory", storeType)
+	}
+	return tokenStore, err
+}
+
+func createFileTokenStore() (keyring.TokenStore, error) {
+	if tokenRecipientsPath != "" {
+		if tokenKeyPath == "" {
+			return nil, fmt.Errorf("--token-recipients needs an age identity as --token-key")
+		}
+		return keyring.NewAgeFileStore(tokenFilePath, tokenKeyPath, tokenRecipientsPath)
+	}
+	if tokenKeyPath != "" {
+		return keyring.NewKeyFileStore(tokenFilePath, tokenKeyPath)
+	}
+
+	passphrase := os.Getenv("GPLAY_TOKEN_PASSPHRASE")
+	if passphrase == "" {
+		log.Infof("Enter passphrase of %s:", tokenFilePath)
+		passwd, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		if err != nil {
+			return nil, err
+		}
+		passphrase = string(passwd)
+	}
+	return keyring.NewFileStore(tokenFilePath, []byte(passphrase))
+}
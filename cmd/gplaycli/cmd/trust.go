@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"github.com/spf13/cobra"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var trustResetAll bool
+
+func init() {
+	trustResetCmd.Flags().BoolVar(&trustResetAll, "all", false, "Remove all pins")
+
+	trustCmd.AddCommand(trustListCmd)
+	trustCmd.AddCommand(trustResetCmd)
+	trustCmd.AddCommand(trustLibraryCmd)
+
+	rootCmd.AddCommand(trustCmd)
+}
+
+var trustCmd = &cobra.Command{
+	Use:   "trust",
+	Short: "Inspect and reset the pinned signing certificates",
+	Long: "The signing certificates of an app are pinned on its first download. " +
+		"Later downloads signed with a different certificate are refused, until the pin is reset",
+}
+
+var trustListCmd = &cobra.Command{
+	Use:   "list [PACKAGE...]",
+	Short: "List the pinned signing certificates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := playstore.LoadTrustStore(trustStorePath)
+		if err != nil {
+			return err
+		}
+
+		pins := store.Pins()
+		if len(args) > 0 {
+			selected := playstore.TrustPins{}
+			for _, packageName := range args {
+				pin, ok := pins[packageName]
+				if !ok {
+					return fmt.Errorf("%s does not have a pinned certificate", packageName)
+				}
+				selected[packageName] = pin
+			}
+			pins = selected
+		}
+		return printOutput(cmd.OutOrStdout(), trustListResult(pins))
+	},
+}
+
+var trustResetCmd = &cobra.Command{
+	Use:   "reset [PACKAGE...]",
+	Short: "Remove pins, the certificates are pinned again on the next download",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !trustResetAll {
+			return fmt.Errorf("specify packages or --all")
+		}
+
+		store, err := playstore.LoadTrustStore(trustStorePath)
+		if err != nil {
+			return err
+		}
+
+		removed := args
+		if len(args) == 0 {
+			for packageName := range store.Pins() {
+				removed = append(removed, packageName)
+			}
+			sort.Strings(removed)
+		}
+
+		if err = store.Reset(args...); err != nil {
+			return err
+		}
+		return printOutput(cmd.OutOrStdout(), trustResetResult(removed))
+	},
+}
+
+var trustLibraryCmd = &cobra.Command{
+	Use:   "library",
+	Short: "Check the certificate hashes of the apps in the account library against the pins",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := playstore.LoadTrustStore(trustStorePath)
+		if err != nil {
+			return err
+		}
+
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		hashes, err := gplay.GetLibraryCertificateHashes()
+		if err != nil {
+			return err
+		}
+
+		var res trustLibraryResult
+		for packageName, hash := range hashes {
+			status := "verified"
+			pinned, err := store.VerifyLibraryHash(packageName, hash)
+			if _, ok := err.(*playstore.CertificateMismatchError); ok {
+				status = "changed"
+			} else if err != nil {
+				return err
+			} else if pinned {
+				status = "pinned"
+			}
+			res = append(res, trustLibraryEntry{PackageName: packageName, CertificateHash: hash, Status: status})
+		}
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].PackageName < res[j].PackageName
+		})
+
+		if err = printOutput(cmd.OutOrStdout(), res); err != nil {
+			return err
+		}
+		if changed := res.changed(); changed > 0 {
+			return fmt.Errorf("signing certificate of %d apps has changed", changed)
+		}
+		return nil
+	},
+}
+
+// In the user config directory, or the working directory if there is none
+func defaultTrustStorePath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "gplay-trust.json"
+	}
+	return filepath.Join(configDir, "gplay", "trust.json")
+}
+
+func createApkVerifier() (*apkVerifier, error) {
+	verifier := &apkVerifier{manifest: verifyManifest, trustWarnOnly: trustWarnOnly}
+	if noTrust {
+		return verifier, nil
+	}
+
+	store, err := playstore.LoadTrustStore(trustStorePath)
+	if err != nil {
+		return nil, err
+	}
+	verifier.trust = store
+	return verifier, nil
+}
+
+type trustListResult playstore.TrustPins
+
+func (res trustListResult) header() []string {
+	return []string{"PACKAGE", "VERSION", "VERIFIED", "CERTIFICATE SHA256", "LIBRARY HASH"}
+}
+
+func (res trustListResult) rows() [][]string {
+	packageNames := make([]string, 0, len(res))
+	for packageName := range res {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	rows := make([][]string, len(packageNames))
+	for i, packageName := range packageNames {
+		pin := res[packageName]
+		rows[i] = []string{
+			packageName,
+			strconv.Itoa(pin.VersionCode),
+			pin.Verified.Format(time.RFC3339),
+			strings.Join(pin.CertSha256, ","),
+			pin.LibraryCertificateHash,
+		}
+	}
+	return rows
+}
+
+// Package names of the removed pins
+type trustResetResult []string
+
+func (res trustResetResult) header() []string {
+	return []string{"PACKAGE", "CHANGE"}
+}
+
+func (res trustResetResult) rows() [][]string {
+	rows := make([][]string, len(res))
+	for i, packageName := range res {
+		rows[i] = []string{packageName, "reset"}
+	}
+	return rows
+}
+
+type trustLibraryEntry struct {
+	PackageName     string `json:"packageName"`
+	CertificateHash string `json:"certificateHash"`
+	// pinned, verified or changed
+	Status string `json:"status"`
+}
+
+type trustLibraryResult []trustLibraryEntry
+
+func (res trustLibraryResult) header() []string {
+	return []string{"PACKAGE", "CERTIFICATE HASH", "STATUS"}
+}
+
+func (res trustLibraryResult) rows() [][]string {
+	rows := make([][]string, len(res))
+	for i, entry := range res {
+		rows[i] = []string{entry.PackageName, entry.CertificateHash, entry.Status}
+	}
+	return rows
+}
+
+func (res trustLibraryResult) changed() int {
+	changed := 0
+	for _, entry := range res {
+		if entry.Status == "changed" {
+			changed++
+		}
+	}
+	return changed
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"github.com/spf13/cobra"
+	"strconv"
+)
+
+var (
+	maxVersionProbes int
+	maxVersions      int
+)
+
+func init() {
+	versionsCmd.Flags().StringVar(&appPackageName, "id", "", "The app package name e.g., \"com.whatsapp\"")
+	_ = versionsCmd.MarkFlagRequired("id")
+	versionsCmd.Flags().IntVar(&maxVersionProbes, "max-probes", 0,
+		"How many version codes below the latest to probe, 50 if not specified")
+	versionsCmd.Flags().IntVar(&maxVersions, "max-versions", 0,
+		"Stop after finding this many versions, no limit if not specified")
+
+	rootCmd.AddCommand(versionsCmd)
+}
+
+var versionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "List the app versions that can still be downloaded",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		versions, err := gplay.GetAvailableVersions(appPackageName, &playstore.VersionProbeOptions{
+			MaxProbes:   maxVersionProbes,
+			MaxVersions: maxVersions,
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(cmd.OutOrStdout(), versionsResult(versions))
+	},
+}
+
+type versionsResult []playstore.AvailableVersion
+
+func (res versionsResult) header() []string {
+	return []string{"VERSION CODE", "SIZE", "SIZE WITH SPLITS"}
+}
+
+func (res versionsResult) rows() [][]string {
+	rows := make([][]string, len(res))
+	for i, version := range res {
+		rows[i] = []string{strconv.Itoa(version.VersionCode),
+			strconv.FormatInt(version.Size, 10), strconv.FormatInt(version.TotalSize, 10)}
+	}
+	return rows
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+var (
+	watchList      string
+	watchStatePath string
+	watchInterval  time.Duration
+	watchWebhook   string
+	watchOnce      bool
+)
+
+func init() {
+	watchCmd.Flags().StringVar(&watchList, "from", "",
+		"File listing the watched apps. Plain text, CSV or YAML (by file extension), version codes are ignored")
+	watchCmd.Flags().StringVar(&watchStatePath, "state", "gplay-watch.json",
+		"File the last seen versions are saved to")
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", playstore.DefaultWatchInterval,
+		"How often the versions are checked")
+	watchCmd.Flags().StringVar(&watchWebhook, "webhook", "",
+		"Also POST each change as JSON to this URL")
+	watchCmd.Flags().BoolVar(&watchOnce, "once", false,
+		"Check once and exit, e.g., when run periodically by cron")
+
+	rootCmd.AddCommand(watchCmd)
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [PACKAGE...]",
+	Short: "Watch apps for new versions",
+	Long: "Watch apps for new versions. Each new version is printed to stdout as a JSON line " +
+		"with the old and new version and the changelog. The first check only records the current versions. " +
+		"A change is reported again on the next check, until all the handlers succeed",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		packageNames := args
+		if watchList != "" {
+			entries, err := readManifest(watchList)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				packageNames = append(packageNames, entry.PackageName)
+			}
+		}
+
+		if len(packageNames) == 0 {
+			return fmt.Errorf("no apps to watch, specify packages or --from")
+		}
+
+		gplay, err := createPlaystoreClient()
+		if err != nil {
+			return err
+		}
+
+		watcher, err := playstore.NewWatcher(gplay, packageNames, watchStatePath)
+		if err != nil {
+			return err
+		}
+
+		handlers := []playstore.ChangeHandler{playstore.JSONLinesHandler(cmd.OutOrStdout())}
+		if watchWebhook != "" {
+			handlers = append(handlers, playstore.WebhookHandler(watchWebhook))
+		}
+
+		if watchOnce {
+			changes, err := watcher.Check()
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				handleVersionChange(watcher, handlers, change)
+			}
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-signals
+			log.Info("Stopping watch")
+			cancel()
+		}()
+
+		log.Infof("Watching %d apps every %v", len(packageNames), watchInterval)
+
+		for change := range watcher.Watch(ctx, watchInterval) {
+			handleVersionChange(watcher, handlers, change)
+		}
+		return nil
+	},
+}
+
+/**
+A failed handler does not prevent the others from handling the change.
+The change is acknowledged only if all the handlers succeed, otherwise it is handled again on the next check
+*/
+func handleVersionChange(watcher *playstore.Watcher, handlers []playstore.ChangeHandler, change playstore.VersionChange) {
+	log.Infof("New version of %s: %d -> %d", change.PackageName, change.OldVersionCode, change.NewVersionCode)
+
+	handled := true
+	for _, handler := range handlers {
+		if err := handler(change); err != nil {
+			log.Errorf("Could not handle new version of %s: %v", change.PackageName, err)
+			handled = false
+		}
+	}
+
+	if !handled {
+		return
+	}
+	if err := watcher.Acknowledge(change); err != nil {
+		log.Errorf("Could not save new version of %s: %v", change.PackageName, err)
+	}
+}
@@ -3,11 +3,14 @@ package main
 import (
 	"github.com/jarijaas/go-gplayapi/cmd/gplaycli/cmd"
 	log "github.com/sirupsen/logrus"
+	"os"
 )
 
 func main()  {
 
 	log.SetFormatter(&log.TextFormatter{ForceColors: true})
+	// Keep stdout for the command output
+	log.SetOutput(os.Stderr)
 
 	cmd.Execute()
 }
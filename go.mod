@@ -3,6 +3,7 @@ module github.com/jarijaas/go-gplayapi
 go 1.15
 
 require (
+	filippo.io/age v1.0.0
 	github.com/Jarijaas/go-tls-exposed v0.0.0-20201219092535-58270dcefea9
 	github.com/afex/hystrix-go v0.0.0-20180502004556-fa1af6a1f4f5 // indirect
 	github.com/cheggaaa/pb/v3 v3.0.5
@@ -11,6 +12,7 @@ require (
 	github.com/sirupsen/logrus v1.7.0
 	github.com/spf13/cobra v1.1.1
 	github.com/zalando/go-keyring v0.1.0
-	golang.org/x/crypto v0.0.0-20201217014255-9d1352758620
+	golang.org/x/crypto v0.0.0-20210817164053-32db794688a5
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.2.8
 )
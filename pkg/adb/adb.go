@@ -0,0 +1,190 @@
+package adb
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Address of the adb server started by "adb start-server"
+const DefaultServerAddr = "127.0.0.1:5037"
+
+const dialTimeout = 5 * time.Second
+
+/**
+Client of the ADB host protocol, talks to a local adb server which forwards the services to the devices
+
+See protocol.txt and SERVICES.TXT of the adb sources
+*/
+type Client struct {
+	addr string
+}
+
+func NewClient(addr string) *Client {
+	if addr == "" {
+		addr = DefaultServerAddr
+	}
+	return &Client{addr: addr}
+}
+
+type Device struct {
+	Serial string `json:"serial"`
+	// E.g., "device", "offline" or "unauthorized"
+	State string `json:"state"`
+}
+
+// Error returned by the server, e.g., "device 'X' not found"
+type ServerError struct {
+	Message string
+}
+
+func (err *ServerError) Error() string {
+	return "adb: " + err.Message
+}
+
+/**
+Version of the adb server protocol
+*/
+func (client *Client) Version() (int, error) {
+	res, err := client.hostRequest("host:version")
+	if err != nil {
+		return 0, err
+	}
+
+	version, err := strconv.ParseInt(string(res), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid adb server version %q", res)
+	}
+	return int(version), nil
+}
+
+/**
+Devices connected to the adb server
+*/
+func (client *Client) Devices() ([]Device, error) {
+	res, err := client.hostRequest("host:devices")
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []Device
+	for _, line := range strings.Split(string(res), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 {
+			devices = append(devices, Device{Serial: fields[0], State: fields[1]})
+		}
+	}
+	return devices, nil
+}
+
+/**
+Run a command on the device without a shell. `stdin` is sent to the command if not nil, the output is returned.
+The input is not closed, like adb does not close it, so the command must know the input length.
+Uses the exec service, so stdout and stderr are combined and the exit code is not known
+*/
+func (client *Client) Exec(serial string, command string, stdin io.Reader) ([]byte, error) {
+	conn, err := client.deviceService(serial, "exec:"+command)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if stdin != nil {
+		if _, err = io.Copy(conn, stdin); err != nil {
+			return nil, err
+		}
+	}
+	return ioutil.ReadAll(conn)
+}
+
+// One-shot host request, returns the length-prefixed response
+func (client *Client) hostRequest(request string) ([]byte, error) {
+	conn, err := client.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err = sendRequest(conn, request); err != nil {
+		return nil, err
+	}
+	return readLengthPrefixed(conn)
+}
+
+/**
+Connect to a service of the device with the serial, any device if the serial is empty.
+The connection is the raw stream of the service
+*/
+func (client *Client) deviceService(serial string, service string) (net.Conn, error) {
+	conn, err := client.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := "host:transport-any"
+	if serial != "" {
+		transport = "host:transport:" + serial
+	}
+
+	if err = sendRequest(conn, transport); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err = sendRequest(conn, service); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (client *Client) dial() (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", client.addr, dialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to adb server at %s, is it running? %v", client.addr, err)
+	}
+	return conn, nil
+}
+
+// Request is prefixed by its length as 4 hex digits, the server replies OKAY or FAIL with a message
+func sendRequest(conn io.ReadWriter, request string) error {
+	if _, err := fmt.Fprintf(conn, "%04x%s", len(request), request); err != nil {
+		return err
+	}
+
+	status := make([]byte, 4)
+	if _, err := io.ReadFull(conn, status); err != nil {
+		return err
+	}
+
+	switch string(status) {
+	case "OKAY":
+		return nil
+	case "FAIL":
+		message, err := readLengthPrefixed(conn)
+		if err != nil {
+			return err
+		}
+		return &ServerError{Message: string(message)}
+	}
+	return fmt.Errorf("invalid adb server status %q", status)
+}
+
+func readLengthPrefixed(r io.Reader) ([]byte, error) {
+	lengthHex := make([]byte, 4)
+	if _, err := io.ReadFull(r, lengthHex); err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.ParseUint(string(lengthHex), 16, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid adb length %q", lengthHex)
+	}
+
+	data := make([]byte, length)
+	_, err = io.ReadFull(r, data)
+	return data, err
+}
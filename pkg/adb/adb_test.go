@@ -0,0 +1,177 @@
+package adb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testSerial = "emulator-5554"
+
+// Fake adb server with one device, implements the package manager install session commands
+type fakeServer struct {
+	listener net.Listener
+
+	mutex sync.Mutex
+	// Written APKs by session id
+	sessions  map[string]map[string][]byte
+	installed map[string][]byte
+	abandoned []string
+	// install-write of this APK fails
+	failWrite string
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &fakeServer{
+		listener:  listener,
+		sessions:  map[string]map[string][]byte{},
+		installed: map[string][]byte{},
+	}
+	go server.serve()
+	return server
+}
+
+func (server *fakeServer) serve() {
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+		go server.handle(conn)
+	}
+}
+
+func (server *fakeServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		request, err := readLengthPrefixed(conn)
+		if err != nil {
+			return
+		}
+
+		switch req := string(request); {
+		case req == "host:version":
+			_, _ = fmt.Fprintf(conn, "OKAY%04x%04x", 4, 41)
+			return
+		case req == "host:devices":
+			devices := testSerial + "\tdevice\n"
+			_, _ = fmt.Fprintf(conn, "OKAY%04x%s", len(devices), devices)
+			return
+		case req == "host:transport:"+testSerial || req == "host:transport-any":
+			_, _ = conn.Write([]byte("OKAY"))
+		case strings.HasPrefix(req, "host:transport:"):
+			message := fmt.Sprintf("device '%s' not found", strings.TrimPrefix(req, "host:transport:"))
+			_, _ = fmt.Fprintf(conn, "FAIL%04x%s", len(message), message)
+			return
+		case strings.HasPrefix(req, "exec:"):
+			_, _ = conn.Write([]byte("OKAY"))
+			_, _ = conn.Write([]byte(server.exec(strings.Fields(strings.TrimPrefix(req, "exec:")), conn)))
+			return
+		default:
+			return
+		}
+	}
+}
+
+func (server *fakeServer) exec(args []string, stdin io.Reader) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if len(args) < 3 || args[0] != "cmd" || args[1] != "package" {
+		return "Unknown command\n"
+	}
+
+	switch args[2] {
+	case "install-create":
+		sessionId := strconv.Itoa(len(server.sessions) + 1)
+		server.sessions[sessionId] = map[string][]byte{}
+		return fmt.Sprintf("Success: created install session [%s]\n", sessionId)
+	case "install-write":
+		// install-write -S <size> <session> <name> -
+		size, _ := strconv.Atoi(args[4])
+		session, name := server.sessions[args[5]], args[6]
+		data := make([]byte, size)
+		if _, err := io.ReadFull(stdin, data); err != nil || session == nil || name == server.failWrite {
+			return "Failure [write failed]\n"
+		}
+		session[name] = data
+		return fmt.Sprintf("Success: streamed %d bytes\n", size)
+	case "install-commit":
+		for name, data := range server.sessions[args[3]] {
+			server.installed[name] = data
+		}
+		return "Success\n"
+	case "install-abandon":
+		server.abandoned = append(server.abandoned, args[3])
+		return "Success\n"
+	}
+	return "Unknown command\n"
+}
+
+func testApks() []InstallApk {
+	return []InstallApk{
+		{Name: "base.apk", Size: 4, Reader: bytes.NewReader([]byte("base"))},
+		{Name: "split_config.arm64_v8a.apk", Size: 5, Reader: bytes.NewReader([]byte("split"))},
+	}
+}
+
+func TestDevices(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.listener.Close()
+
+	client := NewClient(server.listener.Addr().String())
+
+	version, err := client.Version()
+	if err != nil || version != 41 {
+		t.Fatalf("Version is incorrect: %d, %v", version, err)
+	}
+
+	devices, err := client.Devices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 || devices[0].Serial != testSerial || devices[0].State != "device" {
+		t.Fatalf("Devices are incorrect: %+v", devices)
+	}
+
+	_, err = client.Exec("unknown", "cmd package list packages", nil)
+	if _, ok := err.(*ServerError); !ok {
+		t.Fatalf("Unknown device should return server error: %v", err)
+	}
+}
+
+func TestInstallMultiple(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.listener.Close()
+
+	client := NewClient(server.listener.Addr().String())
+	if err := client.InstallMultiple(testSerial, testApks(), "-d"); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(server.installed["base.apk"]) != "base" || string(server.installed["split_config.arm64_v8a.apk"]) != "split" {
+		t.Fatalf("APKs were not installed: %v", server.installed)
+	}
+
+	server.mutex.Lock()
+	server.failWrite = "split_config.arm64_v8a.apk"
+	server.mutex.Unlock()
+
+	if err := client.InstallMultiple(testSerial, testApks()); err == nil {
+		t.Fatalf("Failed write should fail the install")
+	}
+	if len(server.abandoned) != 1 || server.abandoned[0] != "2" {
+		t.Fatalf("Failed session was not abandoned: %v", server.abandoned)
+	}
+}
@@ -0,0 +1,119 @@
+package adb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// APK written to an install session
+type InstallApk struct {
+	// Name in the session, e.g., "base.apk"
+	Name   string
+	Size   int64
+	Reader io.Reader
+}
+
+/**
+Install the APKs as one app, like "adb install-multiple"
+
+Creates a package install session, writes the APKs to it and commits it. The session is abandoned on error.
+`args` are passed to "pm install-create", e.g., "-d" to allow downgrade or "-g" to grant the permissions
+*/
+func (client *Client) InstallMultiple(serial string, apks []InstallApk, args ...string) error {
+	if len(apks) == 0 {
+		return fmt.Errorf("no APKs to install")
+	}
+
+	var totalSize int64
+	for _, apk := range apks {
+		totalSize += apk.Size
+	}
+
+	createArgs := append(append([]string{}, args...), "-S", fmt.Sprint(totalSize))
+	sessionId, err := client.createSession(serial, createArgs)
+	if err != nil {
+		return err
+	}
+
+	for _, apk := range apks {
+		if err = client.writeSession(serial, sessionId, apk); err != nil {
+			client.abandonSession(serial, sessionId)
+			return err
+		}
+	}
+
+	out, err := client.Exec(serial, "cmd package install-commit "+sessionId, nil)
+	if err != nil {
+		client.abandonSession(serial, sessionId)
+		return err
+	}
+	return checkPackageManagerOutput("install-commit", out)
+}
+
+/**
+Install APK files from disk as one app, see `InstallMultiple`
+*/
+func (client *Client) InstallFiles(serial string, paths []string, args ...string) error {
+	var apks []InstallApk
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		stat, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		apks = append(apks, InstallApk{Name: filepath.Base(path), Size: stat.Size(), Reader: f})
+	}
+	return client.InstallMultiple(serial, apks, args...)
+}
+
+var sessionIdRegexp = regexp.MustCompile(`\[(\d+)\]`)
+
+// Output is "Success: created install session [<id>]"
+func (client *Client) createSession(serial string, args []string) (string, error) {
+	out, err := client.Exec(serial, "cmd package install-create "+strings.Join(args, " "), nil)
+	if err != nil {
+		return "", err
+	}
+	if err = checkPackageManagerOutput("install-create", out); err != nil {
+		return "", err
+	}
+
+	match := sessionIdRegexp.FindSubmatch(out)
+	if match == nil {
+		return "", fmt.Errorf("install-create did not return session id: %s", strings.TrimSpace(string(out)))
+	}
+	return string(match[1]), nil
+}
+
+// The APK is streamed to the stdin of "pm install-write", which reads exactly `Size` bytes
+func (client *Client) writeSession(serial string, sessionId string, apk InstallApk) error {
+	command := fmt.Sprintf("cmd package install-write -S %d %s %s -", apk.Size, sessionId, apk.Name)
+	out, err := client.Exec(serial, command, io.LimitReader(apk.Reader, apk.Size))
+	if err != nil {
+		return fmt.Errorf("could not write %s: %v", apk.Name, err)
+	}
+	return checkPackageManagerOutput("install-write "+apk.Name, out)
+}
+
+// Best effort, the session is removed by the device eventually anyway
+func (client *Client) abandonSession(serial string, sessionId string) {
+	_, _ = client.Exec(serial, "cmd package install-abandon "+sessionId, nil)
+}
+
+// Package manager prints "Success" or "Failure [REASON]"
+func checkPackageManagerOutput(command string, out []byte) error {
+	output := strings.TrimSpace(string(out))
+	if !strings.HasPrefix(output, "Success") {
+		return fmt.Errorf("%s failed: %s", command, output)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package apkinfo
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+const manifestName = "AndroidManifest.xml"
+
+/**
+Contents of an APK file, read without external tools
+
+The signatures are not verified, they only tell which certificates the APK claims to be signed with
+*/
+type ApkInfo struct {
+	Manifest
+	// ABIs of the native libraries, e.g., "arm64-v8a". Empty if the APK does not have native code
+	NativeAbis []string `json:"nativeAbis"`
+	// Signatures by scheme, ordered v1, v2, v3
+	Signatures []Signature `json:"signatures"`
+}
+
+/**
+Read APK file, see `Parse`
+*/
+func Open(apkPath string) (*ApkInfo, error) {
+	f, err := os.Open(apkPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(f, stat.Size())
+}
+
+/**
+Parse the manifest, native libraries and signing certificates of an APK
+*/
+func Parse(r io.ReaderAt, size int64) (*ApkInfo, error) {
+	zipReader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("could not read APK: %v", err)
+	}
+
+	info := &ApkInfo{}
+	abis := map[string]bool{}
+
+	for _, file := range zipReader.File {
+		if file.Name == manifestName {
+			manifest, err := readManifest(file)
+			if err != nil {
+				return nil, err
+			}
+			info.Manifest = *manifest
+		}
+
+		// lib/<abi>/<library>.so
+		if parts := strings.Split(file.Name, "/"); len(parts) == 3 && parts[0] == "lib" && strings.HasSuffix(parts[2], ".so") {
+			abis[parts[1]] = true
+		}
+	}
+
+	if info.PackageName == "" {
+		return nil, fmt.Errorf("APK does not contain %s", manifestName)
+	}
+
+	for abi := range abis {
+		info.NativeAbis = append(info.NativeAbis, abi)
+	}
+	sort.Strings(info.NativeAbis)
+
+	v1Signature, err := parseV1Signature(zipReader.File)
+	if err != nil {
+		return nil, err
+	}
+	if v1Signature != nil {
+		info.Signatures = append(info.Signatures, *v1Signature)
+	}
+
+	blockSignatures, err := parseSigningBlock(r, size)
+	if err != nil {
+		return nil, err
+	}
+	info.Signatures = append(info.Signatures, blockSignatures...)
+	return info, nil
+}
+
+func readManifest(file *zip.File) (*Manifest, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := ParseManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", manifestName, err)
+	}
+	return manifest, nil
+}
+
+/**
+SHA-256 of the signing certificates of all schemes without duplicates, as lowercase hex.
+For checking who signed the APK, use `SigningCertSha256`
+*/
+func (info *ApkInfo) CertSha256() []string {
+	var certs []string
+	seen := map[string]bool{}
+
+	for _, signature := range info.Signatures {
+		for _, cert := range signature.CertSha256 {
+			if !seen[cert] {
+				seen[cert] = true
+				certs = append(certs, cert)
+			}
+		}
+	}
+	return certs
+}
+
+/**
+SHA-256 of the certificates of the strongest signature scheme in the APK (v3, v2, v1), sorted, as lowercase hex.
+Android verifies only the strongest scheme, so certificates of the weaker schemes may be anything
+*/
+func (info *ApkInfo) SigningCertSha256() []string {
+	var strongest *Signature
+	for i := range info.Signatures {
+		if strongest == nil || info.Signatures[i].Scheme > strongest.Scheme {
+			strongest = &info.Signatures[i]
+		}
+	}
+	if strongest == nil {
+		return nil
+	}
+
+	var certs []string
+	seen := map[string]bool{}
+	for _, cert := range strongest.CertSha256 {
+		if !seen[cert] {
+			seen[cert] = true
+			certs = append(certs, cert)
+		}
+	}
+	sort.Strings(certs)
+	return certs
+}
@@ -0,0 +1,220 @@
+package apkinfo
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+)
+
+type testAttribute struct {
+	name     uint32
+	dataType byte
+	data     uint32
+}
+
+func chunk(chunkType uint16, headerSize uint16, header []byte, body []byte) []byte {
+	buf := make([]byte, 8, 8+len(header)+len(body))
+	binary.LittleEndian.PutUint16(buf, chunkType)
+	binary.LittleEndian.PutUint16(buf[2:], headerSize)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(8+len(header)+len(body)))
+	return append(append(buf, header...), body...)
+}
+
+func putUint32s(values ...uint32) []byte {
+	buf := make([]byte, 4*len(values))
+	for i, value := range values {
+		binary.LittleEndian.PutUint32(buf[i*4:], value)
+	}
+	return buf
+}
+
+// UTF-16 string pool
+func stringPoolChunk(strs []string) []byte {
+	var offsets, data []byte
+	for _, str := range strs {
+		offsets = append(offsets, putUint32s(uint32(len(data)))...)
+
+		units := utf16.Encode([]rune(str))
+		encoded := make([]byte, 2+2*len(units)+2)
+		binary.LittleEndian.PutUint16(encoded, uint16(len(units)))
+		for i, unit := range units {
+			binary.LittleEndian.PutUint16(encoded[2+i*2:], unit)
+		}
+		data = append(data, encoded...)
+	}
+	for len(data)%4 != 0 {
+		data = append(data, 0)
+	}
+
+	header := putUint32s(uint32(len(strs)), 0, 0, uint32(28+len(offsets)), 0)
+	return chunk(chunkStringPool, 28, header, append(offsets, data...))
+}
+
+func startElementChunk(name uint32, attributes ...testAttribute) []byte {
+	header := putUint32s(1, noIndex)
+
+	ext := putUint32s(noIndex, name)
+	ext = append(ext, 20, 0, 20, 0, byte(len(attributes)), 0, 0, 0, 0, 0, 0, 0)
+	for _, attribute := range attributes {
+		ext = append(ext, putUint32s(noIndex, attribute.name, noIndex)...)
+		ext = append(ext, 8, 0, 0, attribute.dataType)
+		ext = append(ext, putUint32s(attribute.data)...)
+	}
+	return chunk(chunkXmlStart, 16, header, ext)
+}
+
+func endElementChunk(name uint32) []byte {
+	return chunk(chunkXmlEnd, 16, putUint32s(1, noIndex), putUint32s(noIndex, name))
+}
+
+func testManifest() []byte {
+	// The version code attribute name is obfuscated, it is found by its resource id
+	strs := []string{"", "versionName", "name", "package", "manifest", "uses-permission",
+		"android.permission.INTERNET", "com.example.app", "1.0"}
+
+	var body []byte
+	body = append(body, stringPoolChunk(strs)...)
+	body = append(body, chunk(chunkXmlResMap, 8, nil, putUint32s(attrVersionCode, attrVersionName, attrName))...)
+	body = append(body, startElementChunk(4,
+		testAttribute{name: 3, dataType: typeString, data: 7},
+		testAttribute{name: 0, dataType: typeIntDec, data: 42},
+		testAttribute{name: 1, dataType: typeString, data: 8})...)
+	body = append(body, startElementChunk(5, testAttribute{name: 2, dataType: typeString, data: 6})...)
+	body = append(body, endElementChunk(5)...)
+	body = append(body, endElementChunk(4)...)
+	return chunk(chunkXml, 8, nil, body)
+}
+
+func lengthPrefixed(values ...[]byte) []byte {
+	var buf []byte
+	for _, value := range values {
+		buf = append(buf, putUint32s(uint32(len(value)))...)
+		buf = append(buf, value...)
+	}
+	return buf
+}
+
+// Insert APK Signing Block with v2 signature before the central directory
+func addSigningBlock(t *testing.T, apk []byte, cert []byte) []byte {
+	signedData := lengthPrefixed(nil, lengthPrefixed(cert), nil)
+	signer := lengthPrefixed(signedData, nil, nil)
+	value := lengthPrefixed(lengthPrefixed(signer))
+
+	pair := make([]byte, 12)
+	binary.LittleEndian.PutUint64(pair, uint64(4+len(value)))
+	binary.LittleEndian.PutUint32(pair[8:], signingBlockIdV2)
+	pair = append(pair, value...)
+
+	blockSize := make([]byte, 8)
+	binary.LittleEndian.PutUint64(blockSize, uint64(len(pair)+24))
+
+	var block []byte
+	block = append(block, blockSize...)
+	block = append(block, pair...)
+	block = append(block, blockSize...)
+	block = append(block, signingBlockMagic...)
+
+	centralDirOffset, err := findCentralDirectory(bytes.NewReader(apk), int64(len(apk)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := append(append(append([]byte{}, apk[:centralDirOffset]...), block...), apk[centralDirOffset:]...)
+
+	eocd := bytes.LastIndex(signed, putUint32s(eocdSignature))
+	binary.LittleEndian.PutUint32(signed[eocd+16:], uint32(centralDirOffset)+uint32(len(block)))
+	return signed
+}
+
+func TestParseManifest(t *testing.T) {
+	manifest, err := ParseManifest(testManifest())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if manifest.PackageName != "com.example.app" || manifest.VersionCode != 42 || manifest.VersionName != "1.0" {
+		t.Fatalf("Manifest is incorrect: %+v", manifest)
+	}
+	if len(manifest.Permissions) != 1 || manifest.Permissions[0] != "android.permission.INTERNET" {
+		t.Fatalf("Permissions are incorrect: %v", manifest.Permissions)
+	}
+}
+
+func TestParse(t *testing.T) {
+	var buf bytes.Buffer
+	apk := zip.NewWriter(&buf)
+	for name, data := range map[string][]byte{
+		manifestName:                  testManifest(),
+		"lib/arm64-v8a/libexample.so": nil,
+		"lib/x86_64/libexample.so":    nil,
+	} {
+		w, err := apk.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := apk.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	signed := addSigningBlock(t, buf.Bytes(), []byte("certificate"))
+
+	info, err := Parse(bytes.NewReader(signed), int64(len(signed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.PackageName != "com.example.app" {
+		t.Fatalf("Package name is incorrect: %s", info.PackageName)
+	}
+	if len(info.NativeAbis) != 2 || info.NativeAbis[0] != "arm64-v8a" {
+		t.Fatalf("Native ABIs are incorrect: %v", info.NativeAbis)
+	}
+
+	certs := info.CertSha256()
+	if len(info.Signatures) != 1 || info.Signatures[0].Scheme != SchemeV2 || len(certs) != 1 ||
+		certs[0] != certSha256([]byte("certificate")) {
+		t.Fatalf("Signatures are incorrect: %+v", info.Signatures)
+	}
+}
+
+func TestSigningCertSha256(t *testing.T) {
+	info := &ApkInfo{Signatures: []Signature{
+		{Scheme: SchemeV1, CertSha256: []string{"pinned", "foreign"}},
+		{Scheme: SchemeV2, CertSha256: []string{"foreign"}},
+	}}
+
+	// v1 is ignored by Android, when v2 is present
+	if certs := info.SigningCertSha256(); len(certs) != 1 || certs[0] != "foreign" {
+		t.Fatalf("Certificates of the strongest scheme should be returned: %v", certs)
+	}
+}
+
+// Signing block footer with the block size, followed by an EOCD pointing to `centralDirOffset`
+func signingBlockFooter(blockSize uint64, centralDirOffset uint32) []byte {
+	data := make([]byte, 8, 24+eocdMinSize)
+	binary.LittleEndian.PutUint64(data, blockSize)
+	data = append(data, signingBlockMagic...)
+
+	eocd := make([]byte, eocdMinSize)
+	binary.LittleEndian.PutUint32(eocd, eocdSignature)
+	binary.LittleEndian.PutUint32(eocd[16:], centralDirOffset)
+	return append(data, eocd...)
+}
+
+func TestParseSigningBlockInvalidSize(t *testing.T) {
+	for _, data := range [][]byte{
+		signingBlockFooter(1<<62, 24),
+		signingBlockFooter(1<<63+100, 24),
+		signingBlockFooter(100, 1<<31),
+	} {
+		if signatures, err := parseSigningBlock(bytes.NewReader(data), int64(len(data))); err == nil {
+			t.Fatalf("Invalid signing block should fail: %v", signatures)
+		}
+	}
+}
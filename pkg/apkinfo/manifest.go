@@ -0,0 +1,279 @@
+package apkinfo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+)
+
+// Chunk types of the binary XML format, see ResourceTypes.h of the Android framework
+const (
+	chunkStringPool   = 0x0001
+	chunkXml          = 0x0003
+	chunkXmlStart     = 0x0102
+	chunkXmlEnd       = 0x0103
+	chunkXmlResMap    = 0x0180
+	stringPoolUtf8    = 1 << 8
+	noIndex           = 0xffffffff
+	chunkHeaderSize   = 8
+	xmlNodeHeaderSize = 16
+)
+
+// Types of the attribute values
+const (
+	typeReference = 0x01
+	typeString    = 0x03
+	typeIntDec    = 0x10
+	typeIntHex    = 0x11
+	typeBoolean   = 0x12
+)
+
+// Android attribute resource ids, used when the attribute names are obfuscated
+const (
+	attrName             = 0x01010003
+	attrVersionCode      = 0x0101021b
+	attrVersionName      = 0x0101021c
+	attrMinSdkVersion    = 0x0101020c
+	attrTargetSdkVersion = 0x01010270
+)
+
+var androidAttrNames = map[uint32]string{
+	attrName:             "name",
+	attrVersionCode:      "versionCode",
+	attrVersionName:      "versionName",
+	attrMinSdkVersion:    "minSdkVersion",
+	attrTargetSdkVersion: "targetSdkVersion",
+}
+
+type Manifest struct {
+	PackageName string `json:"packageName"`
+	VersionCode int    `json:"versionCode"`
+	// Resource reference like "@0x7f0e0001" if the version name is not a plain string
+	VersionName      string   `json:"versionName"`
+	MinSdkVersion    int      `json:"minSdkVersion"`
+	TargetSdkVersion int      `json:"targetSdkVersion"`
+	Permissions      []string `json:"permissions"`
+}
+
+type xmlAttribute struct {
+	// String value or the integer value formatted
+	value    string
+	intValue int
+}
+
+type xmlParser struct {
+	strings []string
+	// Resource ids of the attribute names by string index
+	resourceIds []uint32
+}
+
+/**
+Parse binary AndroidManifest.xml of an APK
+*/
+func ParseManifest(data []byte) (*Manifest, error) {
+	if len(data) < chunkHeaderSize || binary.LittleEndian.Uint16(data) != chunkXml {
+		return nil, fmt.Errorf("not a binary XML file")
+	}
+
+	parser := &xmlParser{}
+	manifest := &Manifest{}
+	depth := 0
+
+	headerSize := int(binary.LittleEndian.Uint16(data[2:]))
+	for offset := headerSize; offset+chunkHeaderSize <= len(data); {
+		chunkType := binary.LittleEndian.Uint16(data[offset:])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4:]))
+		if chunkSize < chunkHeaderSize || offset+chunkSize > len(data) {
+			return nil, fmt.Errorf("invalid chunk size %d at %d", chunkSize, offset)
+		}
+		chunk := data[offset : offset+chunkSize]
+
+		switch chunkType {
+		case chunkStringPool:
+			if err := parser.parseStringPool(chunk); err != nil {
+				return nil, err
+			}
+		case chunkXmlResMap:
+			chunkHeader := int(binary.LittleEndian.Uint16(chunk[2:]))
+			for i := chunkHeader; i+4 <= len(chunk); i += 4 {
+				parser.resourceIds = append(parser.resourceIds, binary.LittleEndian.Uint32(chunk[i:]))
+			}
+		case chunkXmlStart:
+			name, attributes, err := parser.parseStartElement(chunk)
+			if err != nil {
+				return nil, err
+			}
+			depth++
+			manifest.addElement(depth, name, attributes)
+		case chunkXmlEnd:
+			depth--
+		}
+		offset += chunkSize
+	}
+
+	if manifest.PackageName == "" {
+		return nil, fmt.Errorf("manifest does not contain package name")
+	}
+	return manifest, nil
+}
+
+func (manifest *Manifest) addElement(depth int, name string, attributes map[string]xmlAttribute) {
+	switch {
+	case depth == 1 && name == "manifest":
+		manifest.PackageName = attributes["package"].value
+		manifest.VersionCode = attributes["versionCode"].intValue
+		manifest.VersionName = attributes["versionName"].value
+	case depth == 2 && name == "uses-sdk":
+		manifest.MinSdkVersion = attributes["minSdkVersion"].intValue
+		manifest.TargetSdkVersion = attributes["targetSdkVersion"].intValue
+	case depth == 2 && (name == "uses-permission" || name == "uses-permission-sdk-23"):
+		if permission := attributes["name"].value; permission != "" {
+			manifest.Permissions = append(manifest.Permissions, permission)
+		}
+	}
+}
+
+func (parser *xmlParser) parseStringPool(chunk []byte) error {
+	if len(chunk) < 28 {
+		return fmt.Errorf("string pool is too short")
+	}
+
+	stringCount := int(binary.LittleEndian.Uint32(chunk[8:]))
+	flags := binary.LittleEndian.Uint32(chunk[16:])
+	stringsStart := int(binary.LittleEndian.Uint32(chunk[20:]))
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+
+	if headerSize+stringCount*4 > len(chunk) {
+		return fmt.Errorf("string pool has too many strings: %d", stringCount)
+	}
+
+	parser.strings = make([]string, stringCount)
+	for i := 0; i < stringCount; i++ {
+		offset := stringsStart + int(binary.LittleEndian.Uint32(chunk[headerSize+i*4:]))
+		if offset >= len(chunk) {
+			return fmt.Errorf("string %d is outside the string pool", i)
+		}
+
+		var err error
+		if flags&stringPoolUtf8 != 0 {
+			parser.strings[i], err = decodeUtf8String(chunk[offset:])
+		} else {
+			parser.strings[i], err = decodeUtf16String(chunk[offset:])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Length is one or two bytes, the high bit of the first byte tells if there is the second
+func decodeUtf8Length(data []byte) (length int, size int) {
+	if len(data) == 0 {
+		return 0, 0
+	}
+	if data[0]&0x80 == 0 {
+		return int(data[0]), 1
+	}
+	if len(data) < 2 {
+		return 0, 0
+	}
+	return int(data[0]&0x7f)<<8 | int(data[1]), 2
+}
+
+// Prefixed by the length in UTF-16 code units and in bytes
+func decodeUtf8String(data []byte) (string, error) {
+	_, utf16Size := decodeUtf8Length(data)
+	length, size := decodeUtf8Length(data[utf16Size:])
+	start := utf16Size + size
+	if size == 0 || start+length > len(data) {
+		return "", fmt.Errorf("invalid UTF-8 string in string pool")
+	}
+	return string(data[start : start+length]), nil
+}
+
+// Prefixed by the length in code units, one or two units, the high bit of the first tells if there is the second
+func decodeUtf16String(data []byte) (string, error) {
+	if len(data) < 2 {
+		return "", fmt.Errorf("invalid UTF-16 string in string pool")
+	}
+
+	length := int(binary.LittleEndian.Uint16(data))
+	start := 2
+	if length&0x8000 != 0 {
+		if len(data) < 4 {
+			return "", fmt.Errorf("invalid UTF-16 string in string pool")
+		}
+		length = (length&0x7fff)<<16 | int(binary.LittleEndian.Uint16(data[2:]))
+		start = 4
+	}
+
+	if start+length*2 > len(data) {
+		return "", fmt.Errorf("invalid UTF-16 string in string pool")
+	}
+
+	units := make([]uint16, length)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(data[start+i*2:])
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+func (parser *xmlParser) getString(index uint32) string {
+	if index == noIndex || int(index) >= len(parser.strings) {
+		return ""
+	}
+	return parser.strings[index]
+}
+
+// Attributes by name, Android attributes by their resource id if the names are obfuscated
+func (parser *xmlParser) parseStartElement(chunk []byte) (string, map[string]xmlAttribute, error) {
+	const elementExtSize = 20
+
+	headerSize := int(binary.LittleEndian.Uint16(chunk[2:]))
+	if headerSize < xmlNodeHeaderSize || len(chunk) < headerSize+elementExtSize {
+		return "", nil, fmt.Errorf("element chunk is too short")
+	}
+
+	ext := chunk[headerSize:]
+	name := parser.getString(binary.LittleEndian.Uint32(ext[4:]))
+	attributeStart := int(binary.LittleEndian.Uint16(ext[8:]))
+	attributeSize := int(binary.LittleEndian.Uint16(ext[10:]))
+	attributeCount := int(binary.LittleEndian.Uint16(ext[12:]))
+
+	if headerSize+attributeStart+attributeCount*attributeSize > len(chunk) || attributeSize < 20 {
+		return "", nil, fmt.Errorf("element %s has invalid attributes", name)
+	}
+
+	attributes := map[string]xmlAttribute{}
+	for i := 0; i < attributeCount; i++ {
+		attr := ext[attributeStart+i*attributeSize:]
+
+		nameIndex := binary.LittleEndian.Uint32(attr[4:])
+		attrName := parser.getString(nameIndex)
+		if int(nameIndex) < len(parser.resourceIds) {
+			if androidName, ok := androidAttrNames[parser.resourceIds[nameIndex]]; ok {
+				attrName = androidName
+			}
+		}
+
+		rawValue := binary.LittleEndian.Uint32(attr[8:])
+		dataType := attr[15]
+		data := binary.LittleEndian.Uint32(attr[16:])
+
+		attribute := xmlAttribute{}
+		switch dataType {
+		case typeString:
+			attribute.value = parser.getString(data)
+		case typeIntDec, typeIntHex, typeBoolean:
+			attribute.intValue = int(int32(data))
+			attribute.value = fmt.Sprint(attribute.intValue)
+		case typeReference:
+			attribute.value = fmt.Sprintf("@0x%08x", data)
+		default:
+			attribute.value = parser.getString(rawValue)
+		}
+		attributes[attrName] = attribute
+	}
+	return name, attributes, nil
+}
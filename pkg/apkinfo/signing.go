@@ -0,0 +1,254 @@
+package apkinfo
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+)
+
+// Signature schemes, see https://source.android.com/security/apksigning
+const (
+	SchemeV1 = 1
+	SchemeV2 = 2
+	SchemeV3 = 3
+)
+
+const (
+	signingBlockMagic = "APK Sig Block 42"
+	signingBlockIdV2  = 0x7109871a
+	signingBlockIdV3  = 0xf05368c0
+
+	eocdSignature = 0x06054b50
+	eocdMinSize   = 22
+	// The comment at the end of the EOCD is at most 65535 bytes
+	eocdMaxSearch = eocdMinSize + 65535
+)
+
+type Signature struct {
+	Scheme int `json:"scheme"`
+	// SHA-256 of the signer certificates (DER) as lowercase hex
+	CertSha256 []string `json:"certSha256"`
+}
+
+/**
+Certificates of the JAR signature (v1), from the PKCS #7 signature blocks in META-INF
+*/
+func parseV1Signature(files []*zip.File) (*Signature, error) {
+	signature := &Signature{Scheme: SchemeV1}
+
+	for _, file := range files {
+		dir, name := path.Split(file.Name)
+		ext := strings.ToUpper(path.Ext(name))
+		if dir != "META-INF/" || (ext != ".RSA" && ext != ".DSA" && ext != ".EC") {
+			continue
+		}
+
+		r, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		certs, err := parsePKCS7Certificates(data)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s: %v", file.Name, err)
+		}
+		for _, cert := range certs {
+			signature.CertSha256 = append(signature.CertSha256, certSha256(cert.Raw))
+		}
+	}
+
+	if len(signature.CertSha256) == 0 {
+		return nil, nil
+	}
+	return signature, nil
+}
+
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+func parsePKCS7Certificates(data []byte) ([]*x509.Certificate, error) {
+	var contentInfo pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(data, &contentInfo); err != nil {
+		return nil, err
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificates(signedData.Certificates.Bytes)
+}
+
+/**
+Certificates of the v2 and v3 signatures, from the APK Signing Block before the ZIP central directory
+*/
+func parseSigningBlock(r io.ReaderAt, size int64) ([]Signature, error) {
+	centralDirOffset, err := findCentralDirectory(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if centralDirOffset > size {
+		return nil, fmt.Errorf("invalid central directory offset %d", centralDirOffset)
+	}
+
+	// Footer: size of the block (uint64) and the magic
+	if centralDirOffset < 24 {
+		return nil, nil
+	}
+	footer := make([]byte, 24)
+	if _, err = r.ReadAt(footer, centralDirOffset-24); err != nil {
+		return nil, err
+	}
+	if string(footer[8:]) != signingBlockMagic {
+		return nil, nil
+	}
+
+	// The size does not include the size field at the start of the block.
+	// Checked against the file before allocating, the size is read from the file as is
+	blockSize := binary.LittleEndian.Uint64(footer)
+	if blockSize < 24 || blockSize > uint64(centralDirOffset-8) {
+		return nil, fmt.Errorf("invalid signing block size %d", blockSize)
+	}
+	blockStart := centralDirOffset - int64(blockSize) - 8
+
+	block := make([]byte, blockSize-24)
+	if _, err = r.ReadAt(block, blockStart+8); err != nil {
+		return nil, err
+	}
+
+	var signatures []Signature
+	for pairs := block; len(pairs) > 0; {
+		if len(pairs) < 12 {
+			return nil, fmt.Errorf("invalid signing block entry")
+		}
+		pairSize := binary.LittleEndian.Uint64(pairs)
+		if pairSize < 4 || pairSize > uint64(len(pairs)-8) {
+			return nil, fmt.Errorf("invalid signing block entry size %d", pairSize)
+		}
+		id := binary.LittleEndian.Uint32(pairs[8:])
+		value := pairs[12 : 8+pairSize]
+		pairs = pairs[8+pairSize:]
+
+		var scheme int
+		switch id {
+		case signingBlockIdV2:
+			scheme = SchemeV2
+		case signingBlockIdV3:
+			scheme = SchemeV3
+		default:
+			continue
+		}
+
+		certs, err := parseSchemeSigners(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse v%d signature: %v", scheme, err)
+		}
+		signatures = append(signatures, Signature{Scheme: scheme, CertSha256: certs})
+	}
+	return signatures, nil
+}
+
+func findCentralDirectory(r io.ReaderAt, size int64) (int64, error) {
+	searchSize := int64(eocdMaxSearch)
+	if searchSize > size {
+		searchSize = size
+	}
+
+	tail := make([]byte, searchSize)
+	if _, err := r.ReadAt(tail, size-searchSize); err != nil {
+		return 0, err
+	}
+
+	for i := len(tail) - eocdMinSize; i >= 0; i-- {
+		if binary.LittleEndian.Uint32(tail[i:]) == eocdSignature {
+			return int64(binary.LittleEndian.Uint32(tail[i+16:])), nil
+		}
+	}
+	return 0, fmt.Errorf("end of central directory not found, not a ZIP file")
+}
+
+// Values of v2 and v3 signatures are sequences of length-prefixed (uint32) values
+func readLengthPrefixed(data []byte) (value []byte, rest []byte, err error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("length prefixed value is too short")
+	}
+	length := binary.LittleEndian.Uint32(data)
+	if uint64(length) > uint64(len(data)-4) {
+		return nil, nil, fmt.Errorf("length prefixed value is too long: %d", length)
+	}
+	return data[4 : 4+length], data[4+length:], nil
+}
+
+/**
+SHA-256 of the signer certificates. Both v2 and v3 signers start with the signed data,
+which starts with the digests followed by the certificates
+*/
+func parseSchemeSigners(value []byte) ([]string, error) {
+	signers, _, err := readLengthPrefixed(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var certHashes []string
+	for len(signers) > 0 {
+		var signer []byte
+		if signer, signers, err = readLengthPrefixed(signers); err != nil {
+			return nil, err
+		}
+
+		signedData, _, err := readLengthPrefixed(signer)
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip digests
+		_, rest, err := readLengthPrefixed(signedData)
+		if err != nil {
+			return nil, err
+		}
+
+		certs, _, err := readLengthPrefixed(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		for len(certs) > 0 {
+			var cert []byte
+			if cert, certs, err = readLengthPrefixed(certs); err != nil {
+				return nil, err
+			}
+			certHashes = append(certHashes, certSha256(cert))
+		}
+	}
+	return certHashes, nil
+}
+
+func certSha256(certDER []byte) string {
+	digest := sha256.Sum256(certDER)
+	return hex.EncodeToString(digest[:])
+}
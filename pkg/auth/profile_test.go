@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testGetprop = `[gsm.operator.numeric]: [24405,]
+[gsm.sim.operator.numeric]: [24405]
+[persist.sys.locale]: [en-US]
+[persist.sys.timezone]: [Europe/Helsinki]
+[ro.build.fingerprint]: [google/sunfish/sunfish:11/RQ1A.210105.003/7005429:user/release-keys]
+[ro.build.version.sdk]: [30]
+[ro.product.cpu.abilist]: [arm64-v8a,armeabi-v7a,armeabi]
+[ro.product.model]: [Pixel 4a]
+[ro.sf.lcd_density]: [440]
+`
+
+func TestImportDeviceProfile(t *testing.T) {
+	props, err := ParseGetprop(strings.NewReader(testGetprop))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	features, err := ParsePackageManagerList(strings.NewReader(
+		"feature:android.hardware.camera\nfeature:android.hardware.vulkan.level=1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(features, []string{"android.hardware.camera", "android.hardware.vulkan.level"}) {
+		t.Fatalf("Features are incorrect: %v", features)
+	}
+
+	profile := ImportDeviceProfile(props, features, nil)
+	if profile.Build.GetModel() != "Pixel 4a" || profile.Build.GetSdkVersion() != 30 ||
+		profile.Configuration.GetScreenDensity() != 440 || len(profile.Configuration.GetNativePlatform()) != 3 {
+		t.Fatalf("Imported profile is incorrect: %+v", profile)
+	}
+	if profile.Locale != "en_US" || profile.CellOperator != "24405" {
+		t.Fatalf("Locale or operator is incorrect: %s, %s", profile.Locale, profile.CellOperator)
+	}
+	// Not in the dump, kept from the default profile
+	if len(profile.Configuration.GetSystemSharedLibrary()) == 0 || profile.Build.GetManufacturer() != "unknown" {
+		t.Fatalf("Default values were not kept")
+	}
+
+	dir, err := ioutil.TempDir("", "gplay-profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "device-profile.json")
+	if err = profile.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadDeviceProfile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Build.GetModel() != "Pixel 4a" || loaded.TimeZone != "Europe/Helsinki" ||
+		!reflect.DeepEqual(loaded.Configuration.GetSystemAvailableFeature(), features) {
+		t.Fatalf("Loaded profile is incorrect: %+v", loaded)
+	}
+}
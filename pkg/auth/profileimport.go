@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Line of getprop output, "[ro.product.model]: [Pixel 4a]"
+var getpropLineRegexp = regexp.MustCompile(`^\[([^\]]+)\]:\s*\[(.*)\]$`)
+
+/**
+Parse the output of "adb shell getprop" to a map of property values
+*/
+func ParseGetprop(r io.Reader) (map[string]string, error) {
+	props := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		match := getpropLineRegexp.FindStringSubmatch(line)
+		if match == nil {
+			// Multiline values continue on the next lines
+			continue
+		}
+		props[match[1]] = match[2]
+	}
+	return props, scanner.Err()
+}
+
+/**
+Parse the output of "pm list features" or "pm list libraries", the lines are prefixed by "feature:" or "library:".
+Versions of the features, e.g., "feature:android.hardware.vulkan.level=1", are dropped
+*/
+func ParsePackageManagerList(r io.Reader) ([]string, error) {
+	var values []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		idx := strings.Index(line, ":")
+		if idx == -1 {
+			continue
+		}
+
+		value := line[idx+1:]
+		if eqIdx := strings.Index(value, "="); eqIdx != -1 {
+			value = value[:eqIdx]
+		}
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, scanner.Err()
+}
+
+/**
+Parse a dumped DeviceConfigurationProto, either as protobuf JSON or in the binary format
+*/
+func ParseDeviceConfiguration(data []byte) (*pb.DeviceConfigurationProto, error) {
+	config := &pb.DeviceConfigurationProto{}
+	if err := protojson.Unmarshal(data, proto.MessageV2(config)); err == nil {
+		return config, nil
+	}
+
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("device configuration is neither protobuf JSON nor binary: %v", err)
+	}
+	return config, nil
+}
+
+/**
+Device profile of a real device, based on `DefaultDeviceProfile`
+
+`props` is the parsed getprop output, `features` and `libraries` the parsed "pm list" outputs.
+Values that are missing from the device dump are kept from the default profile,
+e.g., GL extensions and supported locales are not part of getprop output
+*/
+func ImportDeviceProfile(props map[string]string, features []string, libraries []string) *DeviceProfile {
+	profile := DefaultDeviceProfile()
+	build := profile.Build
+	config := profile.Configuration
+
+	setString := func(field **string, keys ...string) {
+		for _, key := range keys {
+			if value := props[key]; value != "" {
+				*field = stringP(value)
+				return
+			}
+		}
+	}
+	setInt := func(field **int32, key string) {
+		if value, err := strconv.ParseInt(props[key], 10, 32); err == nil {
+			*field = intP(int32(value))
+		}
+	}
+
+	setString(&build.Id, "ro.build.fingerprint")
+	setString(&build.Product, "ro.hardware")
+	setString(&build.Carrier, "ro.product.brand")
+	setString(&build.Radio, "gsm.version.baseband")
+	setString(&build.Bootloader, "ro.bootloader")
+	setString(&build.Device, "ro.product.device")
+	setString(&build.Model, "ro.product.model")
+	setString(&build.Manufacturer, "ro.product.manufacturer")
+	setString(&build.BuildProduct, "ro.product.name")
+	setInt(&build.SdkVersion, "ro.build.version.sdk")
+
+	setInt(&config.ScreenDensity, "ro.sf.lcd_density")
+	setInt(&config.GlEsVersion, "ro.opengles.version")
+	if abis := splitList(props["ro.product.cpu.abilist"]); len(abis) > 0 {
+		config.NativePlatform = abis
+	}
+	if len(features) > 0 {
+		config.SystemAvailableFeature = features
+	}
+	if len(libraries) > 0 {
+		config.SystemSharedLibrary = libraries
+	}
+
+	for _, key := range []string{"persist.sys.locale", "ro.product.locale"} {
+		if locale := props[key]; locale != "" {
+			// Checkin uses underscore, e.g., "en_US"
+			profile.Locale = strings.Replace(locale, "-", "_", -1)
+			break
+		}
+	}
+	if timeZone := props["persist.sys.timezone"]; timeZone != "" {
+		profile.TimeZone = timeZone
+	}
+	// Dual SIM devices list the operators of both SIMs
+	if operators := splitList(props["gsm.operator.numeric"]); len(operators) > 0 {
+		profile.CellOperator = operators[0]
+	}
+	if operators := splitList(props["gsm.sim.operator.numeric"]); len(operators) > 0 {
+		profile.SimOperator = operators[0]
+	}
+	return profile
+}
+
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
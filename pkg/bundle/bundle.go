@@ -0,0 +1,267 @@
+package bundle
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/apkinfo"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+/**
+Archive format of an app with its splits and expansion files, for sideloading it as one file
+*/
+type Format string
+
+const (
+	// bundletool APK set, install with "bundletool install-apks"
+	FormatApks Format = "apks"
+	// XAPK, installed by e.g. APKPure and SAI
+	FormatXapk Format = "xapk"
+	// Plain zip, the files named as on devices
+	FormatZip Format = "zip"
+)
+
+const (
+	ManifestName = "manifest.json"
+	IconName     = "icon.png"
+	tocName      = "toc.pb"
+)
+
+func ParseFormat(format string) (Format, error) {
+	switch Format(strings.ToLower(format)) {
+	case FormatApks:
+		return FormatApks, nil
+	case FormatXapk:
+		return FormatXapk, nil
+	case FormatZip:
+		return FormatZip, nil
+	}
+	return "", fmt.Errorf("unknown bundle format %s, use apks, xapk or zip", format)
+}
+
+// File extension with the dot, e.g., ".apks"
+func (format Format) Extension() string {
+	return "." + string(format)
+}
+
+/**
+Files and details of an app version, see `Fetch`
+*/
+type Bundle struct {
+	Info        *playstore.AppInfo
+	VersionCode int
+	// Base APK first, then the splits
+	Apks []playstore.DeliveryFile
+	Obbs []playstore.DeliveryFile
+}
+
+/**
+Get the details and delivery data of an app version, the latest version if `versionCode` is zero.
+The files are downloaded when the bundle is written
+*/
+func Fetch(client *playstore.Client, packageName string, versionCode int) (*Bundle, error) {
+	info, err := client.GetAppInfo(packageName)
+	if err != nil {
+		return nil, err
+	}
+	if versionCode == 0 {
+		versionCode = info.VersionCode
+	}
+
+	deliveryData, err := client.GetAppDeliveryData(packageName, versionCode)
+	if err != nil {
+		return nil, err
+	}
+
+	apks, err := playstore.DeliveryFiles(deliveryData)
+	if err != nil {
+		return nil, err
+	}
+
+	obbs, err := playstore.ObbFiles(packageName, deliveryData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bundle{Info: info, VersionCode: versionCode, Apks: apks, Obbs: obbs}, nil
+}
+
+/**
+Path of the base APK in the archive of the format
+*/
+func (bundle *Bundle) BaseApkPath(format Format) string {
+	return bundle.apkPath(format, bundle.Apks[0])
+}
+
+// Split name of the APK, empty for the base APK
+func splitName(apk playstore.DeliveryFile) string {
+	if apk.Name == playstore.BaseApkName {
+		return ""
+	}
+	return apk.Name
+}
+
+func (bundle *Bundle) apkPath(format Format, apk playstore.DeliveryFile) string {
+	name := splitName(apk)
+
+	switch format {
+	case FormatApks:
+		return tocApkPath(name)
+	case FormatXapk:
+		if name == "" {
+			return bundle.Info.PackageName + ".apk"
+		}
+		return name + ".apk"
+	default:
+		return apk.FileName()
+	}
+}
+
+// Expansion files are where Android expects them, relative to the external storage
+func (bundle *Bundle) obbPath(obb playstore.DeliveryFile) string {
+	return path.Join("Android", "obb", bundle.Info.PackageName, obb.FileName())
+}
+
+/**
+Download the files and write the archive to `w`
+
+The archive has the APKs, the expansion files, the icon and manifest.json, see `Manifest`.
+A missing icon is logged, it does not fail the bundle
+*/
+func (bundle *Bundle) Write(w io.Writer, format Format) error {
+	if len(bundle.Apks) == 0 {
+		return fmt.Errorf("bundle of %s does not have APKs", bundle.Info.PackageName)
+	}
+
+	archive := zip.NewWriter(w)
+
+	if format == FormatApks {
+		var apks []tocApk
+		for _, apk := range bundle.Apks {
+			apks = append(apks, tocApk{splitName: splitName(apk), path: bundle.apkPath(format, apk)})
+		}
+		if err := writeEntry(archive, tocName, encodeToc(bundle.Info.PackageName, apks)); err != nil {
+			return err
+		}
+	}
+
+	for _, apk := range bundle.Apks {
+		if err := downloadEntry(archive, bundle.apkPath(format, apk), apk); err != nil {
+			return err
+		}
+	}
+	for _, obb := range bundle.Obbs {
+		if err := downloadEntry(archive, bundle.obbPath(obb), obb); err != nil {
+			return err
+		}
+	}
+
+	manifest := bundle.Manifest(format)
+	icon, err := downloadIcon(bundle.Info.IconUrl())
+	if err != nil {
+		log.Warnf("Could not add icon of %s: %v", bundle.Info.PackageName, err)
+	} else if icon != nil {
+		if err = writeEntry(archive, IconName, icon); err != nil {
+			return err
+		}
+		manifest.Icon = IconName
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = writeEntry(archive, ManifestName, data); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// APKs and expansion files are already compressed, they are stored as is
+func downloadEntry(archive *zip.Writer, name string, file playstore.DeliveryFile) error {
+	reader, err := file.Download()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	w, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(w, reader); err != nil {
+		return fmt.Errorf("could not download %s: %v", file.FileName(), err)
+	}
+	return nil
+}
+
+func writeEntry(archive *zip.Writer, name string, data []byte) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Nil if the details do not have icon
+func downloadIcon(iconUrl string) ([]byte, error) {
+	if iconUrl == "" {
+		return nil, nil
+	}
+
+	res, err := http.Get(iconUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("incorrect status code: %d", res.StatusCode)
+	}
+	return ioutil.ReadAll(res.Body)
+}
+
+/**
+Parse an APK in a bundle archive, `name` is its path in the archive, e.g., `BaseApkPath`
+
+The APK must be stored without compression, as `Write` stores it
+*/
+func OpenApk(archivePath string, name string) (*apkinfo.ApkInfo, error) {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	for _, file := range archive.File {
+		if file.Name != name {
+			continue
+		}
+		if file.Method != zip.Store {
+			return nil, fmt.Errorf("%s is compressed in %s", name, archivePath)
+		}
+
+		offset, err := file.DataOffset()
+		if err != nil {
+			return nil, err
+		}
+		size := int64(file.UncompressedSize64)
+		return apkinfo.Parse(io.NewSectionReader(f, offset, size), size)
+	}
+	return nil, fmt.Errorf("%s does not contain %s", archivePath, name)
+}
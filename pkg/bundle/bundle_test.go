@@ -0,0 +1,160 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/json"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"google.golang.org/protobuf/encoding/protowire"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testPackageName = "com.example.app"
+
+func testBundle(serverUrl string) *Bundle {
+	file := func(name string, fileType playstore.DeliveryFileType) playstore.DeliveryFile {
+		data := []byte(name)
+		sha1Sum := sha1.Sum(data)
+		sha256Sum := sha256.Sum256(data)
+		return playstore.DeliveryFile{
+			Name:   name,
+			Type:   fileType,
+			Url:    serverUrl + "/" + name,
+			Size:   int64(len(data)),
+			Sha1:   sha1Sum[:],
+			Sha256: sha256Sum[:],
+		}
+	}
+
+	return &Bundle{
+		Info: &playstore.AppInfo{
+			PackageName:   testPackageName,
+			Title:         "Example",
+			VersionCode:   42,
+			VersionString: "1.0",
+			Images: map[playstore.ImageType][]playstore.Image{
+				playstore.ImageTypeIcon: {{Url: serverUrl + "/icon"}},
+			},
+		},
+		VersionCode: 42,
+		Apks: []playstore.DeliveryFile{
+			file(playstore.BaseApkName, playstore.DeliveryFileApk),
+			file("config.arm64_v8a", playstore.DeliveryFileApk),
+		},
+		Obbs: []playstore.DeliveryFile{file("main.42."+testPackageName, playstore.DeliveryFileObb)},
+	}
+}
+
+func readArchive(t *testing.T, data []byte) map[string][]byte {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := map[string][]byte{}
+	for _, file := range archive.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entries[file.Name], err = ioutil.ReadAll(r); err != nil {
+			t.Fatal(err)
+		}
+		r.Close()
+	}
+	return entries
+}
+
+// Values of the length-delimited fields with the number, not recursive
+func bytesFields(t *testing.T, message []byte, number protowire.Number) [][]byte {
+	var values [][]byte
+	for len(message) > 0 {
+		num, typ, n := protowire.ConsumeTag(message)
+		if n < 0 {
+			t.Fatal(protowire.ParseError(n))
+		}
+		message = message[n:]
+
+		if typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(message)
+			if num == number {
+				values = append(values, value)
+			}
+			message = message[n:]
+		} else {
+			message = message[protowire.ConsumeFieldValue(num, typ, message):]
+		}
+	}
+	return values
+}
+
+func TestWrite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Path[1:]))
+	}))
+	defer server.Close()
+
+	bundle := testBundle(server.URL)
+
+	expected := map[Format][]string{
+		FormatZip:  {"base.apk", "split_config.arm64_v8a.apk"},
+		FormatXapk: {testPackageName + ".apk", "config.arm64_v8a.apk"},
+		FormatApks: {"splits/base-master.apk", "splits/base-arm64_v8a.apk", tocName},
+	}
+
+	for format, names := range expected {
+		var buf bytes.Buffer
+		if err := bundle.Write(&buf, format); err != nil {
+			t.Fatal(err)
+		}
+		entries := readArchive(t, buf.Bytes())
+
+		names = append(names, "Android/obb/"+testPackageName+"/main.42."+testPackageName+".obb", IconName, ManifestName)
+		for _, name := range names {
+			if _, ok := entries[name]; !ok {
+				t.Fatalf("%s archive does not contain %s", format, name)
+			}
+		}
+		if string(entries[bundle.BaseApkPath(format)]) != playstore.BaseApkName {
+			t.Fatalf("%s base APK is incorrect", format)
+		}
+
+		var manifest Manifest
+		if err := json.Unmarshal(entries[ManifestName], &manifest); err != nil {
+			t.Fatal(err)
+		}
+		if manifest.VersionCode != "42" || manifest.Icon != IconName || len(manifest.SplitApks) != 2 ||
+			manifest.SplitApks[0].File != bundle.BaseApkPath(format) || len(manifest.Expansions) != 1 {
+			t.Fatalf("%s manifest is incorrect: %+v", format, manifest)
+		}
+
+		if format == FormatApks {
+			variants := bytesFields(t, entries[tocName], buildApksResultVariant)
+			if len(variants) != 1 {
+				t.Fatalf("Table of contents should have one variant")
+			}
+			apkSets := bytesFields(t, variants[0], variantApkSet)
+			if len(apkSets) != 1 || len(bytesFields(t, apkSets[0], apkSetApkDescription)) != 2 {
+				t.Fatalf("Table of contents should have base module with two APKs")
+			}
+		}
+	}
+}
+
+func TestTocApkPath(t *testing.T) {
+	for splitName, expected := range map[string]string{
+		"":                         "splits/base-master.apk",
+		"config.xxhdpi":            "splits/base-xxhdpi.apk",
+		"feature":                  "splits/feature-master.apk",
+		"feature.config.arm64_v8a": "splits/feature-arm64_v8a.apk",
+	} {
+		if path := tocApkPath(splitName); path != expected {
+			t.Fatalf("Path of %s is %s, expected %s", splitName, path, expected)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package bundle
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"strconv"
+)
+
+/**
+Contents of manifest.json in the XAPK format, used by all formats.
+Describes the app from its details, the version name is empty if the bundle is not the latest version
+*/
+type Manifest struct {
+	XapkVersion int    `json:"xapk_version"`
+	PackageName string `json:"package_name"`
+	Name        string `json:"name"`
+	VersionCode string `json:"version_code"`
+	VersionName string `json:"version_name,omitempty"`
+	// Not part of the XAPK format
+	Developer    string   `json:"developer,omitempty"`
+	Permissions  []string `json:"permissions,omitempty"`
+	SplitConfigs []string `json:"split_configs,omitempty"`
+	// Size of the APKs and expansion files
+	TotalSize  int64               `json:"total_size"`
+	Icon       string              `json:"icon,omitempty"`
+	SplitApks  []ManifestApk       `json:"split_apks"`
+	Expansions []ManifestExpansion `json:"expansions,omitempty"`
+}
+
+type ManifestApk struct {
+	File string `json:"file"`
+	// "base" or the split name
+	Id string `json:"id"`
+}
+
+type ManifestExpansion struct {
+	File            string `json:"file"`
+	InstallLocation string `json:"install_location"`
+	InstallPath     string `json:"install_path"`
+}
+
+const xapkVersion = 2
+
+func (bundle *Bundle) Manifest(format Format) *Manifest {
+	manifest := &Manifest{
+		XapkVersion: xapkVersion,
+		PackageName: bundle.Info.PackageName,
+		Name:        bundle.Info.Title,
+		VersionCode: strconv.Itoa(bundle.VersionCode),
+		Developer:   bundle.Info.Developer,
+		Permissions: bundle.Info.Permissions,
+	}
+	if bundle.VersionCode == bundle.Info.VersionCode {
+		manifest.VersionName = bundle.Info.VersionString
+	}
+
+	for _, apk := range bundle.Apks {
+		manifest.TotalSize += apk.Size
+		manifest.SplitApks = append(manifest.SplitApks, ManifestApk{File: bundle.apkPath(format, apk), Id: apk.Name})
+		if apk.Name != playstore.BaseApkName {
+			manifest.SplitConfigs = append(manifest.SplitConfigs, apk.Name)
+		}
+	}
+
+	for _, obb := range bundle.Obbs {
+		manifest.TotalSize += obb.Size
+		manifest.Expansions = append(manifest.Expansions, ManifestExpansion{
+			File:            bundle.obbPath(obb),
+			InstallLocation: "EXTERNAL_STORAGE",
+			InstallPath:     bundle.obbPath(obb),
+		})
+	}
+	return manifest
+}
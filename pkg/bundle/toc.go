@@ -0,0 +1,135 @@
+package bundle
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"strings"
+)
+
+// Field numbers of the bundletool messages, see commands.proto of bundletool
+const (
+	buildApksResultVariant     = 1
+	buildApksResultBundletool  = 2
+	buildApksResultPackageName = 4
+
+	bundletoolVersion = 2
+
+	variantApkSet = 2
+
+	apkSetModuleMetadata       = 1
+	apkSetApkDescription       = 2
+	moduleMetadataName         = 1
+	moduleMetadataDeliveryType = 6
+
+	apkDescriptionPath             = 2
+	apkDescriptionSplitApkMetadata = 3
+
+	splitApkMetadataSplitId       = 1
+	splitApkMetadataIsMasterSplit = 2
+
+	deliveryTypeInstallTime = 1
+)
+
+// Version of bundletool the table of contents is compatible with
+const tocBundletoolVersion = "1.4.0"
+
+// Split APK in the table of contents
+type tocApk struct {
+	// Split name, e.g., "config.arm64_v8a", empty for the base APK
+	splitName string
+	// Path in the archive
+	path string
+}
+
+/**
+Module of a split, "base" for the base APK and its config splits
+
+Splits of feature modules are named "<module>" and "<module>.config.<config>"
+*/
+func splitModule(splitName string) string {
+	if splitName == "" || strings.HasPrefix(splitName, "config.") {
+		return "base"
+	}
+	if i := strings.Index(splitName, ".config."); i >= 0 {
+		return splitName[:i]
+	}
+	return splitName
+}
+
+// Path in the archive, e.g., "splits/base-master.apk" or "splits/base-arm64_v8a.apk" as bundletool names them
+func tocApkPath(splitName string) string {
+	module := splitModule(splitName)
+
+	suffix := "master"
+	if i := strings.Index(splitName, "config."); i >= 0 {
+		suffix = splitName[i+len("config."):]
+	}
+	return "splits/" + module + "-" + suffix + ".apk"
+}
+
+/**
+Encode toc.pb, a BuildApksResult with one variant without targeting
+
+All the APKs are installed by bundletool, the splits were already selected for the device by the playstore
+*/
+func encodeToc(packageName string, apks []tocApk) []byte {
+	var modules []string
+	apksByModule := map[string][]tocApk{}
+	for _, apk := range apks {
+		module := splitModule(apk.splitName)
+		if _, ok := apksByModule[module]; !ok {
+			modules = append(modules, module)
+		}
+		apksByModule[module] = append(apksByModule[module], apk)
+	}
+
+	var variant []byte
+	for _, module := range modules {
+		var metadata []byte
+		metadata = protowire.AppendTag(metadata, moduleMetadataName, protowire.BytesType)
+		metadata = protowire.AppendString(metadata, module)
+		metadata = protowire.AppendTag(metadata, moduleMetadataDeliveryType, protowire.VarintType)
+		metadata = protowire.AppendVarint(metadata, deliveryTypeInstallTime)
+
+		var apkSet []byte
+		apkSet = protowire.AppendTag(apkSet, apkSetModuleMetadata, protowire.BytesType)
+		apkSet = protowire.AppendBytes(apkSet, metadata)
+
+		for _, apk := range apksByModule[module] {
+			// The split id of the base master split is empty, of a feature master split the module name
+			var splitMetadata []byte
+			if apk.splitName != "" {
+				splitMetadata = protowire.AppendTag(splitMetadata, splitApkMetadataSplitId, protowire.BytesType)
+				splitMetadata = protowire.AppendString(splitMetadata, apk.splitName)
+			}
+			if !strings.Contains(apk.splitName, "config.") {
+				splitMetadata = protowire.AppendTag(splitMetadata, splitApkMetadataIsMasterSplit, protowire.VarintType)
+				splitMetadata = protowire.AppendVarint(splitMetadata, 1)
+			}
+
+			var description []byte
+			description = protowire.AppendTag(description, apkDescriptionPath, protowire.BytesType)
+			description = protowire.AppendString(description, apk.path)
+			description = protowire.AppendTag(description, apkDescriptionSplitApkMetadata, protowire.BytesType)
+			description = protowire.AppendBytes(description, splitMetadata)
+
+			apkSet = protowire.AppendTag(apkSet, apkSetApkDescription, protowire.BytesType)
+			apkSet = protowire.AppendBytes(apkSet, description)
+		}
+
+		variant = protowire.AppendTag(variant, variantApkSet, protowire.BytesType)
+		variant = protowire.AppendBytes(variant, apkSet)
+	}
+
+	var bundletool []byte
+	bundletool = protowire.AppendTag(bundletool, bundletoolVersion, protowire.BytesType)
+	bundletool = protowire.AppendString(bundletool, tocBundletoolVersion)
+
+	var toc []byte
+	toc = protowire.AppendTag(toc, buildApksResultVariant, protowire.BytesType)
+	toc = protowire.AppendBytes(toc, variant)
+	toc = protowire.AppendTag(toc, buildApksResultBundletool, protowire.BytesType)
+	toc = protowire.AppendBytes(toc, bundletool)
+	toc = protowire.AppendTag(toc, buildApksResultPackageName, protowire.BytesType)
+	toc = protowire.AppendString(toc, packageName)
+	return toc
+}
@@ -0,0 +1,132 @@
+package fdroid
+
+// F-Droid repository index formats, only the fields the client needs to list and install apps.
+// Timestamps are milliseconds since epoch
+
+const (
+	indexV1Version = 21
+	indexV2Version = 20001
+)
+
+type indexV1 struct {
+	Repo     repoV1                 `json:"repo"`
+	Requests requestsV1             `json:"requests"`
+	Apps     []appV1                `json:"apps"`
+	Packages map[string][]packageV1 `json:"packages"`
+}
+
+type repoV1 struct {
+	Timestamp   int64  `json:"timestamp"`
+	Version     int    `json:"version"`
+	Name        string `json:"name"`
+	Icon        string `json:"icon,omitempty"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+}
+
+type requestsV1 struct {
+	Install   []string `json:"install"`
+	Uninstall []string `json:"uninstall"`
+}
+
+type appV1 struct {
+	PackageName          string   `json:"packageName"`
+	Name                 string   `json:"name"`
+	Summary              string   `json:"summary,omitempty"`
+	Description          string   `json:"description,omitempty"`
+	AuthorName           string   `json:"authorName,omitempty"`
+	Icon                 string   `json:"icon,omitempty"`
+	License              string   `json:"license"`
+	Categories           []string `json:"categories,omitempty"`
+	SuggestedVersionCode string   `json:"suggestedVersionCode"`
+	SuggestedVersionName string   `json:"suggestedVersionName"`
+	Added                int64    `json:"added"`
+	LastUpdated          int64    `json:"lastUpdated"`
+}
+
+type packageV1 struct {
+	PackageName string `json:"packageName"`
+	ApkName     string `json:"apkName"`
+	Hash        string `json:"hash"`
+	HashType    string `json:"hashType"`
+	Size        int64  `json:"size"`
+	VersionCode int    `json:"versionCode"`
+	VersionName string `json:"versionName"`
+	Added       int64  `json:"added"`
+	// Pairs of permission name and max SDK version, null if no max
+	UsesPermission [][2]interface{} `json:"uses-permission,omitempty"`
+}
+
+type entryV2 struct {
+	Timestamp int64                  `json:"timestamp"`
+	Version   int                    `json:"version"`
+	Index     entryFileV2            `json:"index"`
+	Diffs     map[string]entryFileV2 `json:"diffs"`
+}
+
+type entryFileV2 struct {
+	fileV2
+	NumPackages int `json:"numPackages"`
+}
+
+type fileV2 struct {
+	// Path relative to the repository address, starting with "/"
+	Name   string `json:"name"`
+	Sha256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// Localized values by locale, e.g., "en-US"
+type localizedV2 map[string]string
+
+type localizedFileV2 map[string]fileV2
+
+type indexV2 struct {
+	Repo     repoV2               `json:"repo"`
+	Packages map[string]packageV2 `json:"packages"`
+}
+
+type repoV2 struct {
+	Name        localizedV2           `json:"name"`
+	Description localizedV2           `json:"description"`
+	Address     string                `json:"address"`
+	Timestamp   int64                 `json:"timestamp"`
+	Categories  map[string]categoryV2 `json:"categories,omitempty"`
+}
+
+type categoryV2 struct {
+	Name localizedV2 `json:"name"`
+}
+
+type packageV2 struct {
+	Metadata metadataV2           `json:"metadata"`
+	Versions map[string]versionV2 `json:"versions"`
+}
+
+type metadataV2 struct {
+	Name        localizedV2     `json:"name"`
+	Summary     localizedV2     `json:"summary,omitempty"`
+	Description localizedV2     `json:"description,omitempty"`
+	AuthorName  string          `json:"authorName,omitempty"`
+	Icon        localizedFileV2 `json:"icon,omitempty"`
+	License     string          `json:"license"`
+	Categories  []string        `json:"categories,omitempty"`
+	Added       int64           `json:"added"`
+	LastUpdated int64           `json:"lastUpdated"`
+}
+
+type versionV2 struct {
+	Added    int64      `json:"added"`
+	File     fileV2     `json:"file"`
+	Manifest manifestV2 `json:"manifest"`
+}
+
+type manifestV2 struct {
+	VersionName    string             `json:"versionName"`
+	VersionCode    int                `json:"versionCode"`
+	UsesPermission []usesPermissionV2 `json:"usesPermission,omitempty"`
+}
+
+type usesPermissionV2 struct {
+	Name string `json:"name"`
+}
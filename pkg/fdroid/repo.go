@@ -0,0 +1,486 @@
+package fdroid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	IndexV1Name    = "index-v1.json"
+	IndexV1JarName = "index-v1.jar"
+	IndexV2Name    = "index-v2.json"
+	EntryName      = "entry.json"
+	EntryJarName   = "entry.jar"
+
+	DefaultLocale = "en-US"
+
+	iconsDir = "icons"
+	// The playstore does not tell the license
+	unknownLicense = "Unknown"
+)
+
+type Config struct {
+	Name        string
+	Description string
+	// URL the repository directory is served from, e.g., "https://example.org/fdroid/repo"
+	Address string
+	// Locale of the app details, which are in the language of the playstore account. DefaultLocale if empty
+	Locale string
+	Signer *Signer
+}
+
+type SkippedVersion struct {
+	PackageName string `json:"packageName"`
+	VersionCode int    `json:"versionCode"`
+	Reason      string `json:"reason"`
+}
+
+type Repo struct {
+	Dir string `json:"dir"`
+	// Add the repository to F-Droid clients with this fingerprint
+	Fingerprint string           `json:"fingerprint"`
+	Apps        int              `json:"apps"`
+	Versions    int              `json:"versions"`
+	Skipped     []SkippedVersion `json:"skipped,omitempty"`
+}
+
+type repoGenerator struct {
+	config    *Config
+	locale    string
+	mirrorDir string
+	repoDir   string
+	repo      *Repo
+	v1        *indexV1
+	v2        *indexV2
+	// Files in the repository directory, relative to it. Other APKs and icons are removed
+	files map[string]bool
+}
+
+/**
+Generate F-Droid repository from the apps of the mirror in `mirrorDir`, see `playstore.Mirror`
+
+The repository directory contains the APKs, icons, index-v1 and index-v2 signed with the config signer.
+Versions with split APKs are skipped, because F-Droid clients can install only single APKs.
+APKs are hard linked from the mirror when possible. Generating again updates the repository to match the mirror
+*/
+func GenerateRepo(mirrorDir string, repoDir string, config *Config) (*Repo, error) {
+	if config.Name == "" || config.Address == "" {
+		return nil, fmt.Errorf("repository name and address are required")
+	}
+	if config.Signer == nil {
+		return nil, fmt.Errorf("repository signer is required")
+	}
+
+	index, err := playstore.LoadMirrorIndex(mirrorDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(filepath.Join(repoDir, iconsDir), 0755); err != nil {
+		return nil, err
+	}
+
+	locale := config.Locale
+	if locale == "" {
+		locale = DefaultLocale
+	}
+
+	timestamp := millis(time.Now())
+	generator := &repoGenerator{
+		config:    config,
+		locale:    locale,
+		mirrorDir: mirrorDir,
+		repoDir:   repoDir,
+		repo:      &Repo{Dir: repoDir, Fingerprint: config.Signer.Fingerprint()},
+		v1: &indexV1{
+			Repo: repoV1{
+				Timestamp:   timestamp,
+				Version:     indexV1Version,
+				Name:        config.Name,
+				Address:     config.Address,
+				Description: config.Description,
+			},
+			Requests: requestsV1{Install: []string{}, Uninstall: []string{}},
+			Apps:     []appV1{},
+			Packages: map[string][]packageV1{},
+		},
+		v2: &indexV2{
+			Repo: repoV2{
+				Name:        localizedV2{locale: config.Name},
+				Description: localizedV2{locale: config.Description},
+				Address:     config.Address,
+				Timestamp:   timestamp,
+				Categories:  map[string]categoryV2{},
+			},
+			Packages: map[string]packageV2{},
+		},
+		files: map[string]bool{},
+	}
+
+	packageNames := make([]string, 0, len(index.Apps))
+	for packageName := range index.Apps {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	for _, packageName := range packageNames {
+		if err = generator.addApp(index.Apps[packageName]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = generator.removeStaleFiles(); err != nil {
+		return nil, err
+	}
+
+	if err = generator.writeIndexes(timestamp); err != nil {
+		return nil, err
+	}
+	return generator.repo, nil
+}
+
+func (generator *repoGenerator) addApp(app *playstore.MirrorApp) error {
+	info := app.Info
+	if info == nil {
+		info = &playstore.AppInfo{PackageName: app.PackageName}
+	}
+
+	var packagesV1 []packageV1
+	versionsV2 := map[string]versionV2{}
+	var added, lastUpdated int64
+
+	for i, version := range app.Versions {
+		apkFile, err := generator.addApk(app.PackageName, version)
+		if err != nil {
+			return err
+		}
+		if apkFile == nil {
+			continue
+		}
+
+		// The details are only of the latest version
+		var permissions []string
+		if i == 0 {
+			permissions = info.Permissions
+		}
+
+		versionAdded := millis(version.Added)
+		if added == 0 || versionAdded < added {
+			added = versionAdded
+		}
+		if versionAdded > lastUpdated {
+			lastUpdated = versionAdded
+		}
+
+		packageV1 := packageV1{
+			PackageName: app.PackageName,
+			ApkName:     strings.TrimPrefix(apkFile.Name, "/"),
+			Hash:        apkFile.Sha256,
+			HashType:    "sha256",
+			Size:        apkFile.Size,
+			VersionCode: version.VersionCode,
+			VersionName: version.VersionString,
+			Added:       versionAdded,
+		}
+		manifest := manifestV2{VersionName: version.VersionString, VersionCode: version.VersionCode}
+
+		for _, permission := range permissions {
+			packageV1.UsesPermission = append(packageV1.UsesPermission, [2]interface{}{permission, nil})
+			manifest.UsesPermission = append(manifest.UsesPermission, usesPermissionV2{Name: permission})
+		}
+
+		packagesV1 = append(packagesV1, packageV1)
+		versionsV2[apkFile.Sha256] = versionV2{Added: versionAdded, File: *apkFile, Manifest: manifest}
+	}
+
+	if len(packagesV1) == 0 {
+		return nil
+	}
+
+	name := info.Title
+	if name == "" {
+		name = app.PackageName
+	}
+	description := playstore.HtmlToText(info.DescriptionHtml)
+
+	appV1 := appV1{
+		PackageName:          app.PackageName,
+		Name:                 name,
+		Summary:              info.Summary,
+		Description:          description,
+		AuthorName:           info.Developer,
+		License:              unknownLicense,
+		Categories:           info.Categories,
+		SuggestedVersionCode: fmt.Sprint(packagesV1[0].VersionCode),
+		SuggestedVersionName: packagesV1[0].VersionName,
+		Added:                added,
+		LastUpdated:          lastUpdated,
+	}
+	metadata := metadataV2{
+		Name:        localizedV2{generator.locale: name},
+		AuthorName:  info.Developer,
+		License:     unknownLicense,
+		Categories:  info.Categories,
+		Added:       added,
+		LastUpdated: lastUpdated,
+	}
+	if info.Summary != "" {
+		metadata.Summary = localizedV2{generator.locale: info.Summary}
+	}
+	if description != "" {
+		metadata.Description = localizedV2{generator.locale: description}
+	}
+
+	for _, category := range info.Categories {
+		generator.v2.Repo.Categories[category] = categoryV2{Name: localizedV2{generator.locale: category}}
+	}
+
+	iconFile, err := generator.addIcon(info, packagesV1[0].VersionCode)
+	if err != nil {
+		log.Warnf("Could not add icon of %s: %v", app.PackageName, err)
+	} else if iconFile != nil {
+		appV1.Icon = filepath.Base(iconFile.Name)
+		metadata.Icon = localizedFileV2{generator.locale: *iconFile}
+	}
+
+	generator.v1.Apps = append(generator.v1.Apps, appV1)
+	generator.v1.Packages[app.PackageName] = packagesV1
+	generator.v2.Packages[app.PackageName] = packageV2{Metadata: metadata, Versions: versionsV2}
+
+	generator.repo.Apps++
+	generator.repo.Versions += len(packagesV1)
+	return nil
+}
+
+// Nil file if the version cannot be added to the repository
+func (generator *repoGenerator) addApk(packageName string, version playstore.MirrorVersion) (*fileV2, error) {
+	skip := func(reason string) (*fileV2, error) {
+		log.Warnf("Skip %s version %d: %s", packageName, version.VersionCode, reason)
+		generator.repo.Skipped = append(generator.repo.Skipped, SkippedVersion{
+			PackageName: packageName,
+			VersionCode: version.VersionCode,
+			Reason:      reason,
+		})
+		return nil, nil
+	}
+
+	if len(version.Files) == 0 {
+		return skip("version does not have APKs")
+	}
+	if len(version.Files) > 1 {
+		return skip("version has split APKs, which F-Droid clients cannot install")
+	}
+
+	mirrorFile := version.Files[0]
+	apkName := fmt.Sprintf("%s_%d.apk", packageName, version.VersionCode)
+
+	err := linkFile(filepath.Join(generator.mirrorDir, filepath.FromSlash(version.Dir), mirrorFile.Name),
+		filepath.Join(generator.repoDir, apkName), mirrorFile.Size)
+	if os.IsNotExist(err) {
+		return skip("APK is missing from the mirror")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	generator.files[apkName] = true
+	return &fileV2{Name: "/" + apkName, Sha256: mirrorFile.Sha256, Size: mirrorFile.Size}, nil
+}
+
+// Icons are downloaded once per version. Nil file if the details do not have icon
+func (generator *repoGenerator) addIcon(info *playstore.AppInfo, versionCode int) (*fileV2, error) {
+	iconUrl := info.IconUrl()
+	if iconUrl == "" {
+		return nil, nil
+	}
+
+	iconName := filepath.Join(iconsDir, fmt.Sprintf("%s.%d.png", info.PackageName, versionCode))
+	iconPath := filepath.Join(generator.repoDir, iconName)
+
+	if _, err := os.Stat(iconPath); os.IsNotExist(err) {
+		if err = downloadIcon(iconUrl, iconPath); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := ioutil.ReadFile(iconPath)
+	if err != nil {
+		return nil, err
+	}
+
+	generator.files[iconName] = true
+	return &fileV2{
+		Name:   "/" + filepath.ToSlash(iconName),
+		Sha256: sha256Hex(data),
+		Size:   int64(len(data)),
+	}, nil
+}
+
+func downloadIcon(iconUrl string, iconPath string) error {
+	res, err := http.Get(iconUrl)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return fmt.Errorf("incorrect status code: %d", res.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(iconPath, data)
+}
+
+func (generator *repoGenerator) removeStaleFiles() error {
+	for _, pattern := range []string{"*.apk", filepath.Join(iconsDir, "*.png")} {
+		paths, err := filepath.Glob(filepath.Join(generator.repoDir, pattern))
+		if err != nil {
+			return err
+		}
+
+		for _, path := range paths {
+			name, err := filepath.Rel(generator.repoDir, path)
+			if err != nil {
+				return err
+			}
+			if generator.files[name] {
+				continue
+			}
+
+			log.Debugf("Remove %s from the repository", name)
+			if err = os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// The entry is written last, because clients read it first to find the index
+func (generator *repoGenerator) writeIndexes(timestamp int64) error {
+	signer := generator.config.Signer
+
+	indexV1JSON, err := json.Marshal(generator.v1)
+	if err != nil {
+		return err
+	}
+	indexV1Jar, err := signer.SignJar(IndexV1Name, indexV1JSON)
+	if err != nil {
+		return err
+	}
+
+	indexV2JSON, err := json.Marshal(generator.v2)
+	if err != nil {
+		return err
+	}
+
+	entryJSON, err := json.Marshal(&entryV2{
+		Timestamp: timestamp,
+		Version:   indexV2Version,
+		Index: entryFileV2{
+			fileV2: fileV2{
+				Name:   "/" + IndexV2Name,
+				Sha256: sha256Hex(indexV2JSON),
+				Size:   int64(len(indexV2JSON)),
+			},
+			NumPackages: len(generator.v2.Packages),
+		},
+		Diffs: map[string]entryFileV2{},
+	})
+	if err != nil {
+		return err
+	}
+	entryJar, err := signer.SignJar(EntryName, entryJSON)
+	if err != nil {
+		return err
+	}
+
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{IndexV1Name, indexV1JSON},
+		{IndexV1JarName, indexV1Jar},
+		{IndexV2Name, indexV2JSON},
+		{EntryName, entryJSON},
+		{EntryJarName, entryJar},
+	}
+	for _, file := range files {
+		if err = writeFileAtomic(filepath.Join(generator.repoDir, file.name), file.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Hard link the file or copy if linking is not possible, e.g., different file systems.
+// Existing destination with the same size is kept
+func linkFile(src string, dst string, size int64) error {
+	if _, err := os.Stat(src); err != nil {
+		return err
+	}
+
+	if stat, err := os.Stat(dst); err == nil && stat.Size() == size {
+		return nil
+	}
+
+	if err := os.Remove(dst); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.Create(dst + ".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dstFile, srcFile)
+	if closeErr := dstFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst + ".tmp")
+		return err
+	}
+	return os.Rename(dst+".tmp", dst)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func sha256Hex(data []byte) string {
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
+
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,170 @@
+package fdroid
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/json"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestMirror(t *testing.T, mirrorDir string) {
+	apk := []byte("apk")
+
+	index := &playstore.MirrorIndex{Apps: map[string]*playstore.MirrorApp{
+		"com.example.single": {
+			PackageName: "com.example.single",
+			Info:        &playstore.AppInfo{Title: "Single", Permissions: []string{"android.permission.INTERNET"}},
+			Versions: []playstore.MirrorVersion{{
+				VersionCode:   2,
+				VersionString: "2.0",
+				Dir:           "com.example.single/2",
+				Files:         []playstore.MirrorFile{{Name: "base.apk", Size: int64(len(apk)), Sha256: sha256Hex(apk)}},
+				Added:         time.Now(),
+			}},
+		},
+		"com.example.splits": {
+			PackageName: "com.example.splits",
+			Versions: []playstore.MirrorVersion{{
+				VersionCode: 5,
+				Dir:         "com.example.splits/5",
+				Files:       []playstore.MirrorFile{{Name: "base.apk"}, {Name: "split_config.en.apk"}},
+			}},
+		},
+	}}
+
+	if err := os.MkdirAll(filepath.Join(mirrorDir, "com.example.single", "2"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(mirrorDir, "com.example.single", "2", "base.apk"), apk, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(mirrorDir, playstore.MirrorIndexName), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGenerateRepo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fdroid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mirrorDir := filepath.Join(dir, "mirror")
+	repoDir := filepath.Join(dir, "repo")
+	writeTestMirror(t, mirrorDir)
+
+	// Removed from the mirror, should be removed from the repository
+	if err = os.MkdirAll(repoDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(repoDir, "com.example.removed_1.apk"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := GenerateSigner("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := GenerateRepo(mirrorDir, repoDir, &Config{
+		Name:    "Test",
+		Address: "https://example.org/repo",
+		Signer:  signer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repo.Apps != 1 || repo.Versions != 1 || len(repo.Skipped) != 1 {
+		t.Fatalf("Repository contents are incorrect: %+v", repo)
+	}
+
+	if _, err = os.Stat(filepath.Join(repoDir, "com.example.single_2.apk")); err != nil {
+		t.Fatalf("APK was not added: %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(repoDir, "com.example.removed_1.apk")); !os.IsNotExist(err) {
+		t.Fatalf("Stale APK was not removed: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(repoDir, IndexV1Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var index indexV1
+	if err = json.Unmarshal(data, &index); err != nil {
+		t.Fatal(err)
+	}
+
+	packages := index.Packages["com.example.single"]
+	if len(packages) != 1 || packages[0].ApkName != "com.example.single_2.apk" || len(packages[0].UsesPermission) != 1 {
+		t.Fatalf("Index packages are incorrect: %+v", index.Packages)
+	}
+	if len(index.Apps) != 1 || index.Apps[0].SuggestedVersionCode != "2" {
+		t.Fatalf("Index apps are incorrect: %+v", index.Apps)
+	}
+}
+
+func TestSignJar(t *testing.T) {
+	signer, err := GenerateSigner("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jarData, err := signer.SignJar(IndexV1Name, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jar, err := zip.NewReader(bytes.NewReader(jarData), int64(len(jarData)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string][]byte{}
+	for _, file := range jar.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[file.Name], err = ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if string(files[IndexV1Name]) != "{}" {
+		t.Fatalf("Signed file is incorrect: %q", files[IndexV1Name])
+	}
+
+	var signature contentInfo
+	if _, err = asn1.Unmarshal(files["META-INF/"+jarSignerName+".RSA"], &signature); err != nil {
+		t.Fatalf("Could not parse signature block: %v", err)
+	}
+
+	digest := sha256.Sum256(files["META-INF/"+jarSignerName+".SF"])
+	err = rsa.VerifyPKCS1v15(&signer.key.PublicKey, crypto.SHA256, digest[:],
+		signature.Content.SignerInfos[0].EncryptedDigest)
+	if err != nil {
+		t.Fatalf("Signature does not verify: %v", err)
+	}
+
+	if !bytes.Equal(signature.Content.Certificates.Bytes, signer.cert.Raw) {
+		t.Fatal("Signature block does not contain the certificate")
+	}
+}
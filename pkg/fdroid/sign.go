@@ -0,0 +1,276 @@
+package fdroid
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Name of the signature files in the signed JARs, META-INF/<name>.SF and META-INF/<name>.RSA
+const jarSignerName = "GPLAY"
+
+var (
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidRSAEncryption = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+)
+
+/**
+Repository signing key and its certificate
+
+F-Droid clients pin the repository to the SHA-256 fingerprint of the certificate,
+so the same key must be used for every index of the repository
+*/
+type Signer struct {
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+}
+
+func NewSigner(key *rsa.PrivateKey, cert *x509.Certificate) (*Signer, error) {
+	certKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok || certKey.N.Cmp(key.N) != 0 || certKey.E != key.E {
+		return nil, fmt.Errorf("certificate does not match the signing key")
+	}
+	return &Signer{key: key, cert: cert}, nil
+}
+
+/**
+Load PEM encoded RSA private key (PKCS #1 or PKCS #8) and certificate
+
+The certificate may be in the key file, then `certPath` can be empty
+*/
+func LoadSigner(keyPath string, certPath string) (*Signer, error) {
+	keyPEM, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	certPEM := keyPEM
+	if certPath != "" {
+		certPEM, err = ioutil.ReadFile(certPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	return NewSigner(key, cert)
+}
+
+/**
+Create a new 4096 bit RSA key and a self-signed certificate valid for 30 years
+*/
+func GenerateSigner(commonName string) (*Signer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 4096)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(30, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, err
+	}
+	return NewSigner(key, cert)
+}
+
+/**
+PEM encoded private key and certificate, can be loaded with `LoadSigner`
+*/
+func (signer *Signer) EncodePEM() []byte {
+	var buf bytes.Buffer
+	_ = pem.Encode(&buf, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(signer.key)})
+	_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: signer.cert.Raw})
+	return buf.Bytes()
+}
+
+/**
+Repository fingerprint, the SHA-256 of the certificate as uppercase hex
+*/
+func (signer *Signer) Fingerprint() string {
+	fingerprint := sha256.Sum256(signer.cert.Raw)
+	return strings.ToUpper(hex.EncodeToString(fingerprint[:]))
+}
+
+func parsePrivateKey(keyPEM []byte) (*rsa.PrivateKey, error) {
+	for block, rest := pem.Decode(keyPEM); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			return x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			rsaKey, ok := key.(*rsa.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("signing key is not RSA key")
+			}
+			return rsaKey, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find PEM encoded private key")
+}
+
+func parseCertificate(certPEM []byte) (*x509.Certificate, error) {
+	for block, rest := pem.Decode(certPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+	return nil, fmt.Errorf("could not find PEM encoded certificate")
+}
+
+/**
+Create a JAR containing the file, signed with JAR signature scheme (v1) like F-Droid signs its indexes
+*/
+func (signer *Signer) SignJar(fileName string, data []byte) ([]byte, error) {
+	// The lines are short enough to not need wrapping at 72 bytes
+	entry := fmt.Sprintf("Name: %s\r\nSHA-256-Digest: %s\r\n\r\n", fileName, sha256Base64(data))
+	manifest := "Manifest-Version: 1.0\r\nCreated-By: gplay\r\n\r\n" + entry
+	signatureFile := fmt.Sprintf("Signature-Version: 1.0\r\nCreated-By: gplay\r\nSHA-256-Digest-Manifest: %s\r\n\r\n",
+		sha256Base64([]byte(manifest))) +
+		fmt.Sprintf("Name: %s\r\nSHA-256-Digest: %s\r\n\r\n", fileName, sha256Base64([]byte(entry)))
+
+	signatureBlock, err := signer.signPKCS7([]byte(signatureFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	jar := zip.NewWriter(&buf)
+
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{"META-INF/MANIFEST.MF", []byte(manifest)},
+		{"META-INF/" + jarSignerName + ".SF", []byte(signatureFile)},
+		{"META-INF/" + jarSignerName + ".RSA", signatureBlock},
+		{fileName, data},
+	}
+	for _, file := range files {
+		w, err := jar.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(file.data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = jar.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sha256Base64(data []byte) string {
+	digest := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(digest[:])
+}
+
+// PKCS #7 structures, see RFC 2315
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     signedData `asn1:"explicit,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      struct{ ContentType asn1.ObjectIdentifier }
+	Certificates     asn1.RawValue `asn1:"tag:0"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type signerInfo struct {
+	Version                   int
+	IssuerAndSerialNumber     issuerAndSerialNumber
+	DigestAlgorithm           pkix.AlgorithmIdentifier
+	DigestEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedDigest           []byte
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+/**
+Detached PKCS #7 signature of the content, without authenticated attributes
+*/
+func (signer *Signer) signPKCS7(content []byte) ([]byte, error) {
+	digest := sha256.Sum256(content)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, signer.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	sha256Algorithm := pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}
+
+	data := signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{sha256Algorithm},
+		Certificates: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        0,
+			IsCompound: true,
+			Bytes:      signer.cert.Raw,
+		},
+		SignerInfos: []signerInfo{{
+			Version: 1,
+			IssuerAndSerialNumber: issuerAndSerialNumber{
+				Issuer:       asn1.RawValue{FullBytes: signer.cert.RawIssuer},
+				SerialNumber: signer.cert.SerialNumber,
+			},
+			DigestAlgorithm:           sha256Algorithm,
+			DigestEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue},
+			EncryptedDigest:           signature,
+		}},
+	}
+	data.ContentInfo.ContentType = oidData
+
+	return asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: data})
+}
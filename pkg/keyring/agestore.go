@@ -0,0 +1,89 @@
+package keyring
+
+import (
+	"bytes"
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// Prefix of the secret keys in age identity files
+const ageSecretKeyPrefix = "AGE-SECRET-KEY-1"
+
+// Token file in the age format, ASCII armored, so it can also be decrypted with "age --decrypt"
+type ageCipher struct {
+	identities []age.Identity
+	recipients []age.Recipient
+}
+
+/**
+File store encrypted to the age identities in `identityPath`, e.g., created by "age-keygen".
+The file is also encrypted to the recipients in `recipientsPath` if not empty, one public key per line,
+so others can decrypt the tokens with their own identities
+*/
+func NewAgeFileStore(path string, identityPath string, recipientsPath string) (*FileStore, error) {
+	identityFile, err := os.Open(identityPath)
+	if err != nil {
+		return nil, err
+	}
+	defer identityFile.Close()
+
+	identities, err := age.ParseIdentities(identityFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse age identities %s: %v", identityPath, err)
+	}
+
+	c := &ageCipher{identities: identities}
+	for _, identity := range identities {
+		x25519Identity, ok := identity.(*age.X25519Identity)
+		if !ok {
+			return nil, fmt.Errorf("unsupported age identity in %s", identityPath)
+		}
+		c.recipients = append(c.recipients, x25519Identity.Recipient())
+	}
+
+	if recipientsPath != "" {
+		recipientsFile, err := os.Open(recipientsPath)
+		if err != nil {
+			return nil, err
+		}
+		defer recipientsFile.Close()
+
+		recipients, err := age.ParseRecipients(recipientsFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse age recipients %s: %v", recipientsPath, err)
+		}
+		c.recipients = append(c.recipients, recipients...)
+	}
+	return &FileStore{path: path, cipher: c}, nil
+}
+
+func (c *ageCipher) decrypt(data []byte) ([]byte, error) {
+	reader, err := age.Decrypt(armor.NewReader(bytes.NewReader(data)), c.identities...)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(reader)
+}
+
+func (c *ageCipher) encrypt(plaintext []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	armorWriter := armor.NewWriter(&buf)
+
+	writer, err := age.Encrypt(armorWriter, c.recipients...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = writer.Write(plaintext); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	if err = armorWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
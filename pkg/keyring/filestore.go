@@ -0,0 +1,255 @@
+package keyring
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"golang.org/x/crypto/scrypt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const fileStoreVersion = 1
+
+// Recommended scrypt parameters for interactive use
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+/**
+Tokens in an encrypted file. The file is encrypted with AES-256-GCM using a key derived from a secret with scrypt,
+the secret is a passphrase or the content of a key file. With age identities, the file is in the age format instead,
+see `NewAgeFileStore`
+*/
+type FileStore struct {
+	path string
+
+	mutex  sync.Mutex
+	cipher fileCipher
+}
+
+// Encryption of the token file content
+type fileCipher interface {
+	encrypt(plaintext []byte) ([]byte, error)
+	decrypt(data []byte) ([]byte, error)
+}
+
+// Token file encrypted with a key derived from the secret
+type secretCipher struct {
+	secret []byte
+	// Key derived for the salt, derived again only if the salt of the file changes
+	salt []byte
+	key  []byte
+}
+
+type encryptedTokenFile struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+func NewFileStore(path string, secret []byte) (*FileStore, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("passphrase or key of the token file is empty")
+	}
+	return &FileStore{path: path, cipher: &secretCipher{secret: secret}}, nil
+}
+
+/**
+File store with the key file. An age identity file, e.g., created by "age-keygen", is used like in `NewAgeFileStore`,
+the content of any other file is the secret
+*/
+func NewKeyFileStore(path string, keyPath string) (*FileStore, error) {
+	secret, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Contains(secret, []byte(ageSecretKeyPrefix)) {
+		return NewAgeFileStore(path, keyPath, "")
+	}
+	return NewFileStore(path, bytes.TrimSpace(secret))
+}
+
+func (store *FileStore) Get(key string) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	values, err := store.load()
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := values[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (store *FileStore) Set(key string, value string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	values, err := store.load()
+	if err != nil {
+		return err
+	}
+	values[key] = value
+	return store.save(values)
+}
+
+func (store *FileStore) Delete(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	values, err := store.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := values[key]; !ok {
+		return ErrNotFound
+	}
+	delete(values, key)
+	return store.save(values)
+}
+
+func (c *secretCipher) deriveKey(salt []byte) ([]byte, error) {
+	if c.key != nil && bytes.Equal(c.salt, salt) {
+		return c.key, nil
+	}
+
+	key, err := scrypt.Key(c.secret, salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	c.salt, c.key = salt, key
+	return key, nil
+}
+
+func (c *secretCipher) aead(salt []byte) (cipher.AEAD, error) {
+	key, err := c.deriveKey(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (c *secretCipher) decrypt(data []byte) ([]byte, error) {
+	var file encryptedTokenFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid file: %v", err)
+	}
+	if file.Version != fileStoreVersion {
+		return nil, fmt.Errorf("unsupported version %d", file.Version)
+	}
+
+	aead, err := c.aead(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("is the passphrase or key correct?")
+	}
+	return plaintext, nil
+}
+
+func (c *secretCipher) encrypt(plaintext []byte) ([]byte, error) {
+	salt := c.salt
+	if salt == nil {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+	}
+
+	aead, err := c.aead(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&encryptedTokenFile{
+		Version: fileStoreVersion,
+		Salt:    salt,
+		Nonce:   nonce,
+		Data:    aead.Seal(nil, nonce, plaintext, nil),
+	})
+}
+
+// Missing file is an empty store
+func (store *FileStore) load() (map[string]string, error) {
+	data, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := store.cipher.decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt token file %s: %v", store.path, err)
+	}
+
+	values := map[string]string{}
+	if err = json.Unmarshal(plaintext, &values); err != nil {
+		return nil, fmt.Errorf("invalid token file %s: %v", store.path, err)
+	}
+	return values, nil
+}
+
+// Written to a temporary file first, so a failed write does not lose the tokens
+func (store *FileStore) save(values map[string]string) error {
+	plaintext, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	data, err := store.cipher.encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(store.path), 0700); err != nil {
+		return err
+	}
+
+	// Unique name, so processes sharing the token file do not write the same temporary file
+	tmp, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), store.path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
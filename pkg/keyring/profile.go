@@ -0,0 +1,198 @@
+package keyring
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Keyring entry of the profile list
+const profilesKey = "profiles"
+
+/**
+Named account with its own tokens, e.g., for a region or a device.
+The tokens of the unnamed profile "" are saved under the plain token type, like `SaveToken` saves them
+*/
+type Profile struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	// Path of the device profile file used in checkin, default device if empty
+	DeviceProfile string `json:"deviceProfile,omitempty"`
+	// Locale of the account, e.g., "fi-FI"
+	Locale string `json:"locale,omitempty"`
+}
+
+type profileList struct {
+	Default  string    `json:"default,omitempty"`
+	Profiles []Profile `json:"profiles"`
+}
+
+func profileTokenKey(profile string, tokenType TokenType) string {
+	if profile == "" {
+		return string(tokenType)
+	}
+	return profile + "/" + string(tokenType)
+}
+
+func SaveProfileToken(store TokenStore, profile string, tokenType TokenType, token string) error {
+	return store.Set(profileTokenKey(profile, tokenType), token)
+}
+
+func GetProfileToken(store TokenStore, profile string, tokenType TokenType) (string, error) {
+	return store.Get(profileTokenKey(profile, tokenType))
+}
+
+func DeleteProfileToken(store TokenStore, profile string, tokenType TokenType) error {
+	return store.Delete(profileTokenKey(profile, tokenType))
+}
+
+/**
+Get GSFID and AuthSub token of the profile from the keyring
+*/
+func GetProfileGoogleTokens(store TokenStore, profile string) (gsfId string, authSub string, err error) {
+	authSub, err = GetProfileToken(store, profile, AuthSubToken)
+	if err != nil {
+		return
+	}
+	gsfId, err = GetProfileToken(store, profile, GSFID)
+	return
+}
+
+func loadProfileList(store TokenStore) (*profileList, error) {
+	data, err := store.Get(profilesKey)
+	if err == ErrNotFound {
+		return &profileList{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	list := &profileList{}
+	if err = json.Unmarshal([]byte(data), list); err != nil {
+		return nil, fmt.Errorf("invalid profile list in keyring: %v", err)
+	}
+	return list, nil
+}
+
+func (list *profileList) save(store TokenStore) error {
+	sort.Slice(list.Profiles, func(i, j int) bool {
+		return list.Profiles[i].Name < list.Profiles[j].Name
+	})
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return store.Set(profilesKey, string(data))
+}
+
+func (list *profileList) index(name string) int {
+	for i, profile := range list.Profiles {
+		if profile.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+/**
+Profiles sorted by name and the name of the default profile, empty if not set
+*/
+func Profiles(store TokenStore) (profiles []Profile, defaultProfile string, err error) {
+	list, err := loadProfileList(store)
+	if err != nil {
+		return nil, "", err
+	}
+	return list.Profiles, list.Default, nil
+}
+
+/**
+Get the profile by name, the default profile if `name` is empty.
+Returns nil without error, if `name` is empty and the default profile is not set
+*/
+func GetProfile(store TokenStore, name string) (*Profile, error) {
+	list, err := loadProfileList(store)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		if list.Default == "" {
+			return nil, nil
+		}
+		name = list.Default
+	}
+
+	idx := list.index(name)
+	if idx == -1 {
+		return nil, fmt.Errorf("profile %s does not exist", name)
+	}
+	return &list.Profiles[idx], nil
+}
+
+/**
+Add the profile or replace the profile with the same name. The first profile becomes the default
+*/
+func SaveProfile(store TokenStore, profile Profile) error {
+	if profile.Name == "" {
+		return fmt.Errorf("profile name is empty")
+	}
+
+	list, err := loadProfileList(store)
+	if err != nil {
+		return err
+	}
+
+	if idx := list.index(profile.Name); idx != -1 {
+		list.Profiles[idx] = profile
+	} else {
+		list.Profiles = append(list.Profiles, profile)
+	}
+	if list.Default == "" {
+		list.Default = profile.Name
+	}
+	return list.save(store)
+}
+
+/**
+Remove the profile and its tokens
+*/
+func RemoveProfile(store TokenStore, name string) error {
+	list, err := loadProfileList(store)
+	if err != nil {
+		return err
+	}
+
+	idx := list.index(name)
+	if idx == -1 {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	for _, tokenType := range []TokenType{GSFID, AuthSubToken} {
+		if err = DeleteProfileToken(store, name, tokenType); err != nil && err != ErrNotFound {
+			return err
+		}
+	}
+
+	list.Profiles = append(list.Profiles[:idx], list.Profiles[idx+1:]...)
+	if list.Default == name {
+		list.Default = ""
+	}
+	return list.save(store)
+}
+
+/**
+Use the profile, when no profile is selected
+*/
+func SetDefaultProfile(store TokenStore, name string) error {
+	list, err := loadProfileList(store)
+	if err != nil {
+		return err
+	}
+
+	if list.index(name) == -1 {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+	list.Default = name
+	return list.save(store)
+}
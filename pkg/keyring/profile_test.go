@@ -0,0 +1,53 @@
+package keyring
+
+import (
+	"testing"
+)
+
+func TestProfiles(t *testing.T) {
+	store := NewMemoryStore()
+
+	for _, profile := range []Profile{{Name: "us", Locale: "en-US"}, {Name: "fi", Email: "fi@example.org"}} {
+		if err := SaveProfile(store, profile); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	profiles, defaultProfile, err := Profiles(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 2 || profiles[0].Name != "fi" || defaultProfile != "us" {
+		t.Fatalf("Profiles are incorrect: %+v, default %s", profiles, defaultProfile)
+	}
+
+	if err = SaveProfileToken(store, "fi", GSFID, "fi-gsfid"); err != nil {
+		t.Fatal(err)
+	}
+	if err = SaveProfileToken(store, "", GSFID, "unnamed-gsfid"); err != nil {
+		t.Fatal(err)
+	}
+	if token, err := GetProfileToken(store, "fi", GSFID); err != nil || token != "fi-gsfid" {
+		t.Fatalf("Profile token is incorrect: %s, %v", token, err)
+	}
+
+	if err = SetDefaultProfile(store, "fi"); err != nil {
+		t.Fatal(err)
+	}
+	if profile, err := GetProfile(store, ""); err != nil || profile.Email != "fi@example.org" {
+		t.Fatalf("Default profile is incorrect: %+v, %v", profile, err)
+	}
+
+	if err = RemoveProfile(store, "fi"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = GetProfileToken(store, "fi", GSFID); err != ErrNotFound {
+		t.Fatalf("Tokens of the removed profile were not deleted: %v", err)
+	}
+	if profile, err := GetProfile(store, ""); err != nil || profile != nil {
+		t.Fatalf("Removed default profile is still the default: %+v, %v", profile, err)
+	}
+	if token, err := store.Get("gsfid"); err != nil || token != "unnamed-gsfid" {
+		t.Fatalf("Token of the unnamed profile changed: %s, %v", token, err)
+	}
+}
@@ -0,0 +1,142 @@
+package keyring
+
+import (
+	"errors"
+	"github.com/zalando/go-keyring"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	// Returned by `TokenStore.Get`, if the key does not exist
+	ErrNotFound = errors.New("token not found")
+	// Returned by stores that cannot save tokens, e.g., `EnvStore`
+	ErrReadOnly = errors.New("token store is read-only")
+)
+
+/**
+Storage of the tokens and profiles. Keys are, e.g., "gsfid", "<profile>/authsub-token" and "profiles"
+*/
+type TokenStore interface {
+	Get(key string) (string, error)
+	Set(key string, value string) error
+	Delete(key string) error
+}
+
+/**
+Tokens in the OS keyring under the service, e.g., Secret Service on Linux or Keychain on macOS.
+Needs a desktop session on Linux
+*/
+type OSKeyring struct {
+	Service string
+}
+
+func NewOSKeyring() *OSKeyring {
+	return &OSKeyring{Service: Service}
+}
+
+func (store *OSKeyring) Get(key string) (string, error) {
+	value, err := keyring.Get(store.Service, key)
+	if err == keyring.ErrNotFound {
+		return "", ErrNotFound
+	}
+	return value, err
+}
+
+func (store *OSKeyring) Set(key string, value string) error {
+	return keyring.Set(store.Service, key, value)
+}
+
+func (store *OSKeyring) Delete(key string) error {
+	err := keyring.Delete(store.Service, key)
+	if err == keyring.ErrNotFound {
+		return ErrNotFound
+	}
+	return err
+}
+
+/**
+Tokens in memory, lost when the process exits
+*/
+type MemoryStore struct {
+	mutex  sync.Mutex
+	values map[string]string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: map[string]string{}}
+}
+
+func (store *MemoryStore) Get(key string) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	value, ok := store.values[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (store *MemoryStore) Set(key string, value string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.values[key] = value
+	return nil
+}
+
+func (store *MemoryStore) Delete(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if _, ok := store.values[key]; !ok {
+		return ErrNotFound
+	}
+	delete(store.values, key)
+	return nil
+}
+
+/**
+Read-only tokens from environment variables, e.g., for CI. See `EnvVarName` for the variable names
+*/
+type EnvStore struct{}
+
+var envTokenNames = map[string]string{
+	string(GSFID):        "GSFID",
+	string(AuthSubToken): "AUTHSUB",
+}
+
+var envInvalidCharsRegexp = regexp.MustCompile(`[^A-Z0-9]+`)
+
+/**
+Environment variable of the key: GPLAY_GSFID and GPLAY_AUTHSUB for the unnamed profile,
+e.g., GPLAY_FI_GSFID for the profile "fi" and GPLAY_PROFILES for the profile list
+*/
+func EnvVarName(key string) string {
+	parts := strings.Split(key, "/")
+	if name, ok := envTokenNames[parts[len(parts)-1]]; ok {
+		parts[len(parts)-1] = name
+	}
+
+	name := strings.ToUpper(strings.Join(parts, "_"))
+	return "GPLAY_" + strings.Trim(envInvalidCharsRegexp.ReplaceAllString(name, "_"), "_")
+}
+
+func (store EnvStore) Get(key string) (string, error) {
+	value, ok := os.LookupEnv(EnvVarName(key))
+	if !ok || value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (store EnvStore) Set(key string, value string) error {
+	return ErrReadOnly
+}
+
+func (store EnvStore) Delete(key string) error {
+	return ErrReadOnly
+}
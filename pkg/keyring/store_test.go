@@ -0,0 +1,145 @@
+package keyring
+
+import (
+	"filippo.io/age"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gplay-tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tokens.json")
+	store, err := NewFileStore(path, []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = store.Get("gsfid"); err != ErrNotFound {
+		t.Fatalf("Missing file should not have tokens: %v", err)
+	}
+	if err = store.Set("gsfid", "123"); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Set("fi/gsfid", "456"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopened with the same passphrase
+	store, _ = NewFileStore(path, []byte("passphrase"))
+	if token, err := store.Get("gsfid"); err != nil || token != "123" {
+		t.Fatalf("Token is incorrect: %s, %v", token, err)
+	}
+	if err = store.Delete("fi/gsfid"); err != nil {
+		t.Fatal(err)
+	}
+
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Fatalf("Temporary files were left in the token file directory: %d files", len(files))
+	}
+
+	wrongStore, _ := NewFileStore(path, []byte("wrong"))
+	if _, err = wrongStore.Get("gsfid"); err == nil {
+		t.Fatalf("Wrong passphrase should fail")
+	}
+}
+
+func writeAgeIdentity(t *testing.T, path string) *age.X25519Identity {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := fmt.Sprintf("# public key: %s\n%s\n", identity.Recipient(), identity)
+	if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return identity
+}
+
+func TestAgeFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gplay-tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tokens.age")
+	identityPath := filepath.Join(dir, "key.txt")
+	writeAgeIdentity(t, identityPath)
+
+	// Another identity the file is also encrypted to
+	otherIdentityPath := filepath.Join(dir, "other.txt")
+	otherIdentity := writeAgeIdentity(t, otherIdentityPath)
+	recipientsPath := filepath.Join(dir, "recipients.txt")
+	if err = ioutil.WriteFile(recipientsPath, []byte(otherIdentity.Recipient().String()+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := NewAgeFileStore(path, identityPath, recipientsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Set("gsfid", "123"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil || !strings.HasPrefix(string(data), "-----BEGIN AGE ENCRYPTED FILE-----") {
+		t.Fatalf("Token file is not in the age format: %s, %v", data, err)
+	}
+
+	// The age identity is detected from the key file
+	for _, keyPath := range []string{identityPath, otherIdentityPath} {
+		store, err = NewKeyFileStore(path, keyPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token, err := store.Get("gsfid"); err != nil || token != "123" {
+			t.Fatalf("Token decrypted with %s is incorrect: %s, %v", keyPath, token, err)
+		}
+	}
+
+	wrongStore, err := NewAgeFileStore(path, filepath.Join(dir, "wrong.txt"), "")
+	if err == nil {
+		t.Fatalf("Missing identity file should fail")
+	}
+	writeAgeIdentity(t, filepath.Join(dir, "wrong.txt"))
+	if wrongStore, err = NewAgeFileStore(path, filepath.Join(dir, "wrong.txt"), ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = wrongStore.Get("gsfid"); err == nil {
+		t.Fatalf("Wrong identity should fail")
+	}
+}
+
+func TestEnvStore(t *testing.T) {
+	for key, name := range map[string]string{
+		"gsfid":         "GPLAY_GSFID",
+		"authsub-token": "GPLAY_AUTHSUB",
+		"eu-fi/gsfid":   "GPLAY_EU_FI_GSFID",
+		"profiles":      "GPLAY_PROFILES",
+	} {
+		if envName := EnvVarName(key); envName != name {
+			t.Errorf("Env var of %s is %s, expected %s", key, envName, name)
+		}
+	}
+
+	os.Setenv("GPLAY_EU_FI_AUTHSUB", "token")
+	defer os.Unsetenv("GPLAY_EU_FI_AUTHSUB")
+
+	store := EnvStore{}
+	if token, err := GetProfileToken(store, "eu-fi", AuthSubToken); err != nil || token != "token" {
+		t.Fatalf("Token is incorrect: %s, %v", token, err)
+	}
+	if err := store.Set("gsfid", "123"); err != ErrReadOnly {
+		t.Fatalf("Env store should be read-only: %v", err)
+	}
+}
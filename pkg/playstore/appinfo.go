@@ -0,0 +1,236 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type ImageType int
+
+const (
+	ImageTypeScreenshot     ImageType = 1
+	ImageTypeFeatureGraphic ImageType = 2
+	ImageTypeVideoThumbnail ImageType = 3
+	ImageTypeIcon           ImageType = 4
+)
+
+type Restriction int
+
+// Why the app is not available to the device or account, from details availability
+const (
+	RestrictionNone             Restriction = 1
+	RestrictionGeo              Restriction = 2
+	RestrictionDevice           Restriction = 7
+	RestrictionCarrier          Restriction = 8
+	RestrictionCountryOrCarrier Restriction = 9
+)
+
+var restrictionDescriptions = map[Restriction]string{
+	RestrictionNone:             "available",
+	RestrictionGeo:              "not available in the account country",
+	RestrictionDevice:           "not compatible with the device",
+	RestrictionCarrier:          "not available for the carrier",
+	RestrictionCountryOrCarrier: "not available in the country or for the carrier",
+}
+
+func (restriction Restriction) String() string {
+	if description, ok := restrictionDescriptions[restriction]; ok {
+		return description
+	}
+	return fmt.Sprintf("restricted (%d)", int(restriction))
+}
+
+// Layouts the server formats the upload date with, depends on the account locale
+var uploadDateLayouts = []string{
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"2.1.2006",
+	"2006-01-02",
+}
+
+type Image struct {
+	Url    string `json:"url"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+}
+
+type Dependency struct {
+	PackageName string `json:"packageName"`
+	VersionCode int    `json:"versionCode,omitempty"`
+}
+
+type Price struct {
+	Micros       int64  `json:"micros"`
+	CurrencyCode string `json:"currencyCode,omitempty"`
+	// Formatted in the account locale, e.g., "0,99 €". Empty for free apps
+	Formatted string `json:"formatted,omitempty"`
+}
+
+/**
+App details without the nested optional protobuf fields
+
+Fields the server did not return have zero value.
+Use `NewAppInfo` to create from details or `Client.GetAppInfo` to fetch
+*/
+type AppInfo struct {
+	PackageName string `json:"packageName"`
+	Title       string `json:"title"`
+	Developer   string `json:"developer"`
+	// Short description, e.g., shown below the title
+	Summary         string   `json:"summary"`
+	DescriptionHtml string   `json:"descriptionHtml"`
+	Categories      []string `json:"categories"`
+	VersionCode     int      `json:"versionCode"`
+	VersionString   string   `json:"versionString"`
+	// Zero if the server did not return the date or its format is unknown
+	UploadDate time.Time `json:"uploadDate"`
+	// Lower bound of the installs, e.g., 1000000 for "1,000,000+ downloads"
+	Installs int64 `json:"installs"`
+	// Star rating between 1 and 5, zero if not rated
+	StarRating   float32 `json:"starRating"`
+	RatingsCount uint64  `json:"ratingsCount"`
+	// Number of one to five star ratings, index 0 is one star
+	RatingHistogram [5]uint64    `json:"ratingHistogram"`
+	Permissions     []string     `json:"permissions"`
+	Dependencies    []Dependency `json:"dependencies"`
+	Price           Price        `json:"price"`
+	// E.g., "PEGI 3"
+	ContentRating     string                `json:"contentRating"`
+	InstallationSize  int64                 `json:"installationSize"`
+	RecentChangesHtml string                `json:"recentChangesHtml"`
+	Images            map[ImageType][]Image `json:"images"`
+	// Zero if the details did not contain availability
+	Restriction      Restriction `json:"restriction"`
+	AvailableIfOwned bool        `json:"availableIfOwned"`
+}
+
+func NewAppInfo(doc *pb.DocV2) *AppInfo {
+	appDetails := doc.GetDetails().GetAppDetails()
+	rating := doc.GetAggregateRating()
+
+	info := &AppInfo{
+		PackageName:       doc.GetDocid(),
+		Title:             doc.GetTitle(),
+		Developer:         doc.GetCreator(),
+		Summary:           doc.GetDescriptionShort(),
+		DescriptionHtml:   doc.GetDescriptionHtml(),
+		Categories:        appDetails.GetAppCategory(),
+		VersionCode:       int(appDetails.GetVersionCode()),
+		VersionString:     appDetails.GetVersionString(),
+		UploadDate:        parseUploadDate(appDetails.GetUploadDate()),
+		Installs:          parseInstalls(appDetails.GetNumDownloads()),
+		StarRating:        rating.GetStarRating(),
+		RatingsCount:      rating.GetRatingsCount(),
+		Permissions:       appDetails.GetPermission(),
+		ContentRating:     doc.GetRelatedLinks().GetRated().GetLabel(),
+		InstallationSize:  appDetails.GetInstallationSize(),
+		RecentChangesHtml: appDetails.GetRecentChangesHtml(),
+		Images:            map[ImageType][]Image{},
+		Restriction:       Restriction(doc.GetAvailability().GetRestriction()),
+		AvailableIfOwned:  doc.GetAvailability().GetAvailableIfOwned(),
+		RatingHistogram: [5]uint64{
+			rating.GetOneStarRatings(),
+			rating.GetTwoStarRatings(),
+			rating.GetThreeStarRatings(),
+			rating.GetFourStarRatings(),
+			rating.GetFiveStarRatings(),
+		},
+	}
+
+	if info.PackageName == "" {
+		info.PackageName = appDetails.GetPackageName()
+	}
+	if info.Developer == "" {
+		info.Developer = appDetails.GetDeveloperName()
+	}
+
+	for _, dependency := range appDetails.GetDependencies().GetDependency() {
+		info.Dependencies = append(info.Dependencies, Dependency{
+			PackageName: dependency.GetPackageName(),
+			VersionCode: int(dependency.GetVersion()),
+		})
+	}
+
+	if offer := selectOffer(doc); offer != nil {
+		info.Price = Price{
+			Micros:       offer.GetMicros(),
+			CurrencyCode: offer.GetCurrencyCode(),
+			Formatted:    offer.GetFormattedAmount(),
+		}
+	}
+
+	for _, image := range doc.GetImage() {
+		imageType := ImageType(image.GetImageType())
+		info.Images[imageType] = append(info.Images[imageType], Image{
+			Url:    image.GetImageUrl(),
+			Width:  int(image.GetDimension().GetWidth()),
+			Height: int(image.GetDimension().GetHeight()),
+		})
+	}
+	return info
+}
+
+// Whether the app can be downloaded with the device config and account
+func (info *AppInfo) IsAvailable() bool {
+	return info.Restriction == 0 || info.Restriction == RestrictionNone
+}
+
+func (info *AppInfo) IsFree() bool {
+	return info.Price.Micros == 0
+}
+
+// URL of the app icon, empty if the details did not contain icon
+func (info *AppInfo) IconUrl() string {
+	icons := info.Images[ImageTypeIcon]
+	if len(icons) == 0 {
+		return ""
+	}
+	return icons[0].Url
+}
+
+/**
+Get app details by its package name, see `AppInfo`
+*/
+func (client *Client) GetAppInfo(packageName string) (*AppInfo, error) {
+	doc, err := client.GetDetails(packageName)
+	if err != nil {
+		return nil, err
+	}
+	return NewAppInfo(doc), nil
+}
+
+func parseUploadDate(uploadDate string) time.Time {
+	for _, layout := range uploadDateLayouts {
+		date, err := time.Parse(layout, uploadDate)
+		if err == nil {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// Parse number of downloads, e.g., "1,000,000+ downloads" or "5 000 000+"
+func parseInstalls(numDownloads string) int64 {
+	if idx := strings.Index(numDownloads, "+"); idx != -1 {
+		numDownloads = numDownloads[:idx]
+	}
+
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, numDownloads)
+
+	installs, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return installs
+}
@@ -0,0 +1,63 @@
+package playstore
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"testing"
+	"time"
+)
+
+func TestAppInfoMissingDetails(t *testing.T) {
+	info := NewAppInfo(&pb.DocV2{Docid: proto.String(TestPackageName)})
+
+	if info.PackageName != TestPackageName {
+		t.Fatalf("Package name is incorrect: %s", info.PackageName)
+	}
+
+	if info.VersionCode != 0 || !info.UploadDate.IsZero() || info.IconUrl() != "" {
+		t.Fatalf("Missing fields should have zero value: %+v", info)
+	}
+}
+
+func TestAppInfo(t *testing.T) {
+	info := NewAppInfo(&pb.DocV2{
+		Docid: proto.String(TestPackageName),
+		Details: &pb.DocumentDetails{AppDetails: &pb.AppDetails{
+			VersionCode:  proto.Int32(210),
+			UploadDate:   proto.String("Dec 21, 2020"),
+			NumDownloads: proto.String("5,000,000,000+ downloads"),
+		}},
+		AggregateRating: &pb.AggregateRating{FiveStarRatings: proto.Uint64(3)},
+		Image: []*pb.Image{
+			{ImageType: proto.Int32(int32(ImageTypeIcon)), ImageUrl: proto.String("https://example.org/icon")},
+		},
+	})
+
+	if info.VersionCode != 210 {
+		t.Fatalf("Version code is incorrect: %d", info.VersionCode)
+	}
+
+	if !info.UploadDate.Equal(time.Date(2020, 12, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Upload date is incorrect: %v", info.UploadDate)
+	}
+
+	if info.Installs != 5000000000 {
+		t.Fatalf("Installs is incorrect: %d", info.Installs)
+	}
+
+	if info.RatingHistogram[4] != 3 {
+		t.Fatalf("Rating histogram is incorrect: %v", info.RatingHistogram)
+	}
+
+	if info.IconUrl() != "https://example.org/icon" {
+		t.Fatalf("Icon URL is incorrect: %s", info.IconUrl())
+	}
+}
+
+func TestHtmlToText(t *testing.T) {
+	text := HtmlToText("<b>New</b> features:<br><ul><li>Dark mode</li><li>Q&amp;A</li></ul>")
+
+	if text != "New features:\n\n• Dark mode\n• Q&A" {
+		t.Fatalf("Converted text is incorrect: %q", text)
+	}
+}
@@ -0,0 +1,49 @@
+package playstore
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+)
+
+const BulkDetailsUrl = FDFEUrl + "bulkDetails"
+
+// Max number of apps per bulk details request
+const maxBulkDetails = 100
+
+/**
+Get details of many apps using as few requests as possible
+
+The details are returned in the same order as `packageNames`.
+The details of apps that the server did not return, e.g., removed apps, are nil
+*/
+func (client *Client) GetBulkDetails(packageNames []string) ([]*pb.DocV2, error) {
+	docs := make([]*pb.DocV2, 0, len(packageNames))
+
+	for start := 0; start < len(packageNames); start += maxBulkDetails {
+		end := start + maxBulkDetails
+		if end > len(packageNames) {
+			end = len(packageNames)
+		}
+
+		res, err := client.sendProto(BulkDetailsUrl, &pb.BulkDetailsRequest{
+			Docid:            packageNames[start:end],
+			IncludeChildDocs: proto.Bool(false),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Match by docid, the entries of missing apps may be omitted
+		byPackageName := map[string]*pb.DocV2{}
+		for _, entry := range res.GetPayload().GetBulkDetailsResponse().GetEntry() {
+			if entry.GetDoc() != nil {
+				byPackageName[entry.GetDoc().GetDocid()] = entry.GetDoc()
+			}
+		}
+
+		for _, packageName := range packageNames[start:end] {
+			docs = append(docs, byPackageName[packageName])
+		}
+	}
+	return docs, nil
+}
@@ -0,0 +1,75 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+// Bulk details of the requested apps in reverse order, without the apps in `missing`
+func bulkDetailsResponder(missing map[string]bool) func(req *http.Request) (*pb.ResponseWrapper, error) {
+	return func(req *http.Request) (*pb.ResponseWrapper, error) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		bulkReq := &pb.BulkDetailsRequest{}
+		if err = proto.Unmarshal(body, bulkReq); err != nil {
+			return nil, err
+		}
+
+		res := &pb.BulkDetailsResponse{}
+		for i := len(bulkReq.Docid) - 1; i >= 0; i-- {
+			if missing[bulkReq.Docid[i]] {
+				// The server returns an empty entry or omits the entry
+				if i%2 == 0 {
+					res.Entry = append(res.Entry, &pb.BulkDetailsEntry{})
+				}
+				continue
+			}
+			doc := detailsResponse(bulkReq.Docid[i], 1).GetPayload().GetDetailsResponse().GetDocV2()
+			res.Entry = append(res.Entry, &pb.BulkDetailsEntry{Doc: doc})
+		}
+		return &pb.ResponseWrapper{Payload: &pb.Payload{BulkDetailsResponse: res}}, nil
+	}
+}
+
+func TestGetBulkDetails(t *testing.T) {
+	var packageNames []string
+	for i := 0; i < maxBulkDetails+50; i++ {
+		packageNames = append(packageNames, fmt.Sprintf("com.example.app%d", i))
+	}
+	missing := map[string]bool{packageNames[1]: true, packageNames[2]: true, packageNames[maxBulkDetails]: true}
+
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"bulkDetails": bulkDetailsResponder(missing),
+	}}
+	client := createFakePlayStoreClient(t, store, nil)
+
+	docs, err := client.GetBulkDetails(packageNames)
+	if err != nil {
+		t.Fatalf("Could not get bulk details: %v", err)
+	}
+
+	if len(docs) != len(packageNames) {
+		t.Fatalf("Number of details is incorrect: %d, should be: %d", len(docs), len(packageNames))
+	}
+	for i, packageName := range packageNames {
+		if missing[packageName] {
+			if docs[i] != nil {
+				t.Fatalf("Details of missing %s should be nil: %v", packageName, docs[i])
+			}
+			continue
+		}
+		if docs[i].GetDocid() != packageName {
+			t.Fatalf("Details %d are incorrect: %s, should be: %s", i, docs[i].GetDocid(), packageName)
+		}
+	}
+
+	if requests := store.requested("bulkDetails"); requests != 2 {
+		t.Fatalf("Number of bulk details requests is incorrect: %d", requests)
+	}
+}
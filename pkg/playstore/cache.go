@@ -0,0 +1,336 @@
+package playstore
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Response headers the server tells the cache lifetime of the response with, in milliseconds
+	softTTLHeader = "X-DFE-Soft-TTL"
+	hardTTLHeader = "X-DFE-Hard-TTL"
+
+	DefaultMemoryCacheSize = 256
+)
+
+/**
+Cached response
+
+The entry is fresh until `SoftExpires`. After that, it is revalidated with its etag if it has one,
+and it is removed after `Expires`
+*/
+type CacheEntry struct {
+	Response    *pb.ResponseWrapper
+	Etag        string
+	SoftExpires time.Time
+	Expires     time.Time
+	// Stored from the prefetched responses of another response and not used yet
+	Prefetched bool
+}
+
+func (entry *CacheEntry) isFresh(now time.Time) bool {
+	return now.Before(entry.SoftExpires)
+}
+
+func (entry *CacheEntry) isExpired(now time.Time) bool {
+	return !now.Before(entry.Expires)
+}
+
+/**
+Storage for responses by cache key, see `MemoryCache` and `DiskCache`
+
+Implementations must be safe for concurrent use
+*/
+type ResponseCache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+	Clear()
+}
+
+/**
+Create cache entry from the server TTLs in milliseconds, the soft TTL is the hard TTL if zero.
+
+The server uses both relative and absolute (since epoch) TTLs, values after `now` are treated as absolute
+*/
+func newCacheEntry(response *pb.ResponseWrapper, etag string, now time.Time, ttl int64, softTTL int64) *CacheEntry {
+	if softTTL == 0 || softTTL > ttl {
+		softTTL = ttl
+	}
+	return &CacheEntry{
+		Response:    response,
+		Etag:        etag,
+		SoftExpires: ttlExpiry(now, softTTL),
+		Expires:     ttlExpiry(now, ttl),
+	}
+}
+
+func ttlExpiry(now time.Time, ttl int64) time.Time {
+	nowMillis := now.UnixNano() / int64(time.Millisecond)
+	if ttl > nowMillis {
+		return time.Unix(0, ttl*int64(time.Millisecond))
+	}
+	return now.Add(time.Duration(ttl) * time.Millisecond)
+}
+
+func parseTTLHeader(value string) int64 {
+	ttl, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ttl < 0 {
+		return 0
+	}
+	return ttl
+}
+
+/**
+Response cache usage of a client, see `Client.CacheStats`
+*/
+type CacheStats struct {
+	// Responses served from the cache, including revalidated
+	Hits int64 `json:"hits"`
+	// Responses fetched, because they were not cached or were modified
+	Misses int64 `json:"misses"`
+	// Stale responses the server confirmed not modified
+	Revalidated int64 `json:"revalidated"`
+	// Prefetched responses stored to the cache
+	Prefetched int64 `json:"prefetched"`
+	// Prefetched responses that were used, each is counted once
+	PrefetchHits int64 `json:"prefetchHits"`
+}
+
+// Fraction of the cacheable requests served from the cache
+func (stats CacheStats) HitRate() float64 {
+	if stats.Hits+stats.Misses == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Hits+stats.Misses)
+}
+
+// Fraction of the prefetched responses that were used
+func (stats CacheStats) PrefetchHitRate() float64 {
+	if stats.Prefetched == 0 {
+		return 0
+	}
+	return float64(stats.PrefetchHits) / float64(stats.Prefetched)
+}
+
+/**
+In-memory cache, the least recently used entries are evicted when the cache is full
+*/
+type MemoryCache struct {
+	mutex      sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	// Most recently used first
+	lru *list.List
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+/**
+Create memory cache holding at most `maxEntries`, DefaultMemoryCacheSize if zero
+*/
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMemoryCacheSize
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+func (cache *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	cache.lru.MoveToFront(element)
+	return copyCacheEntry(element.Value.(*memoryCacheItem).entry), true
+}
+
+func (cache *MemoryCache) Set(key string, entry *CacheEntry) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entry = copyCacheEntry(entry)
+
+	if element, ok := cache.entries[key]; ok {
+		element.Value.(*memoryCacheItem).entry = entry
+		cache.lru.MoveToFront(element)
+		return
+	}
+
+	cache.entries[key] = cache.lru.PushFront(&memoryCacheItem{key: key, entry: entry})
+
+	for cache.lru.Len() > cache.maxEntries {
+		oldest := cache.lru.Back()
+		cache.lru.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+func (cache *MemoryCache) Delete(key string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.entries[key]; ok {
+		cache.lru.Remove(element)
+		delete(cache.entries, key)
+	}
+}
+
+func (cache *MemoryCache) Clear() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.entries = map[string]*list.Element{}
+	cache.lru.Init()
+}
+
+func (cache *MemoryCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.lru.Len()
+}
+
+// Callers get their own copy of the response, so modifying it does not change the cached response
+func copyCacheEntry(entry *CacheEntry) *CacheEntry {
+	entryCopy := *entry
+	entryCopy.Response = proto.Clone(entry.Response).(*pb.ResponseWrapper)
+	return &entryCopy
+}
+
+/**
+Cache persisted to a directory, an entry per file. Expired entries are removed when read
+*/
+type DiskCache struct {
+	dir string
+	// Serializes writes of the same entry
+	mutex sync.Mutex
+}
+
+type diskCacheEntry struct {
+	// Serialized ResponseWrapper
+	Response    []byte    `json:"response"`
+	Etag        string    `json:"etag,omitempty"`
+	SoftExpires time.Time `json:"softExpires"`
+	Expires     time.Time `json:"expires"`
+	Prefetched  bool      `json:"prefetched,omitempty"`
+}
+
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// The keys contain URLs, so the files are named by key hash
+func (cache *DiskCache) entryPath(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+	return filepath.Join(cache.dir, hex.EncodeToString(keyHash[:]))
+}
+
+func (cache *DiskCache) Get(key string) (*CacheEntry, bool) {
+	data, err := ioutil.ReadFile(cache.entryPath(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var diskEntry diskCacheEntry
+	if err = json.Unmarshal(data, &diskEntry); err != nil {
+		cache.Delete(key)
+		return nil, false
+	}
+
+	entry := &CacheEntry{
+		Response:    &pb.ResponseWrapper{},
+		Etag:        diskEntry.Etag,
+		SoftExpires: diskEntry.SoftExpires,
+		Expires:     diskEntry.Expires,
+		Prefetched:  diskEntry.Prefetched,
+	}
+	if entry.isExpired(time.Now()) {
+		cache.Delete(key)
+		return nil, false
+	}
+
+	if err = proto.Unmarshal(diskEntry.Response, entry.Response); err != nil {
+		cache.Delete(key)
+		return nil, false
+	}
+	return entry, true
+}
+
+// Failing to write the entry is not an error, the response is fetched again next time
+func (cache *DiskCache) Set(key string, entry *CacheEntry) {
+	response, err := proto.Marshal(entry.Response)
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(&diskCacheEntry{
+		Response:    response,
+		Etag:        entry.Etag,
+		SoftExpires: entry.SoftExpires,
+		Expires:     entry.Expires,
+		Prefetched:  entry.Prefetched,
+	})
+	if err != nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entryPath := cache.entryPath(key)
+	if err = ioutil.WriteFile(entryPath+".tmp", data, 0600); err != nil {
+		return
+	}
+	_ = os.Rename(entryPath+".tmp", entryPath)
+}
+
+func (cache *DiskCache) Delete(key string) {
+	_ = os.Remove(cache.entryPath(key))
+}
+
+// Entry files are named by the hex SHA-256 of the key, temporary files have ".tmp" suffix
+var diskCacheEntryRegexp = regexp.MustCompile(`^[0-9a-f]{64}(\.tmp)?$`)
+
+/**
+Remove the entries of the cache. Only the files named like entries are removed,
+other files in the directory are kept
+*/
+func (cache *DiskCache) Clear() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	files, err := ioutil.ReadDir(cache.dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		if file.Mode().IsRegular() && diskCacheEntryRegexp.MatchString(file.Name()) {
+			_ = os.Remove(filepath.Join(cache.dir, file.Name()))
+		}
+	}
+}
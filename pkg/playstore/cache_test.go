@@ -0,0 +1,176 @@
+package playstore
+
+import (
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/auth"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testCacheEntry(docid string, ttl time.Duration) *CacheEntry {
+	return newCacheEntry(&pb.ResponseWrapper{Payload: &pb.Payload{DetailsResponse: &pb.DetailsResponse{
+		DocV2: &pb.DocV2{Docid: proto.String(docid)},
+	}}}, "etag", time.Now(), int64(ttl/time.Millisecond), 0)
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", testCacheEntry("a", time.Hour))
+	cache.Set("b", testCacheEntry("b", time.Hour))
+	cache.Get("a")
+	cache.Set("c", testCacheEntry("c", time.Hour))
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("Least recently used entry was not evicted")
+	}
+
+	entry, ok := cache.Get("a")
+	if !ok || entry.Response.GetPayload().GetDetailsResponse().GetDocV2().GetDocid() != "a" {
+		t.Fatalf("Entry is incorrect: %v", entry)
+	}
+
+	// Modifying the returned response does not change the cached response
+	entry.Response.Payload = nil
+	if entry, _ = cache.Get("a"); entry.Response.GetPayload() == nil {
+		t.Fatal("Cached response was modified")
+	}
+
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Fatalf("Cache was not cleared, has %d entries", cache.Len())
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gplay-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Set(DetailsUrl+"?doc=a", testCacheEntry("a", time.Hour))
+	cache.Set(DetailsUrl+"?doc=b", testCacheEntry("b", -time.Hour))
+
+	entry, ok := cache.Get(DetailsUrl + "?doc=a")
+	if !ok || entry.Etag != "etag" || entry.Response.GetPayload().GetDetailsResponse().GetDocV2().GetDocid() != "a" {
+		t.Fatalf("Entry is incorrect: %v", entry)
+	}
+
+	if _, ok = cache.Get(DetailsUrl + "?doc=b"); ok {
+		t.Fatal("Expired entry was returned")
+	}
+
+	// The directory may be shared with other files
+	foreignPath := filepath.Join(dir, "notes.txt")
+	if err = ioutil.WriteFile(foreignPath, []byte("notes"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Clear()
+	if _, ok = cache.Get(DetailsUrl + "?doc=a"); ok {
+		t.Fatal("Cache was not cleared")
+	}
+	if _, err = os.Stat(foreignPath); err != nil {
+		t.Fatalf("Clear removed a file that is not a cache entry: %v", err)
+	}
+}
+
+func TestCacheEntryTTL(t *testing.T) {
+	now := time.Now()
+
+	entry := newCacheEntry(&pb.ResponseWrapper{}, "", now, 60000, 1000)
+	if !entry.SoftExpires.Equal(now.Add(time.Second)) || !entry.Expires.Equal(now.Add(time.Minute)) {
+		t.Fatalf("Relative TTL is incorrect: %+v", entry)
+	}
+
+	expires := now.Add(time.Hour).Truncate(time.Millisecond)
+	entry = newCacheEntry(&pb.ResponseWrapper{}, "", now, expires.UnixNano()/int64(time.Millisecond), 0)
+	if !entry.Expires.Equal(expires) || !entry.SoftExpires.Equal(expires) {
+		t.Fatalf("Absolute TTL is incorrect: %+v", entry)
+	}
+	if !entry.isFresh(now) || entry.isExpired(now) {
+		t.Fatalf("Entry should be fresh: %+v", entry)
+	}
+}
+
+func TestPrefetchedResponse(t *testing.T) {
+	authClient, err := auth.CreatePlaystoreAuthClient(&auth.Config{GsfId: "1", AuthSubToken: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &Client{authClient: authClient, cache: NewMemoryCache(0)}
+
+	prefetched := testCacheEntry(TestPackageName, time.Hour).Response
+	client.storePrefetched(&pb.ResponseWrapper{PreFetch: []*pb.PreFetch{{
+		Url:      proto.String("details?doc=" + TestPackageName),
+		Response: prefetched,
+		Ttl:      proto.Int64(60000),
+	}}})
+
+	// Served from the cache, otherwise the request would fail with the invalid token
+	for i := 0; i < 2; i++ {
+		doc, err := client.GetDetails(TestPackageName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc.GetDocid() != TestPackageName {
+			t.Fatalf("Details are incorrect: %v", doc)
+		}
+	}
+
+	stats := client.CacheStats()
+	if stats.Hits != 2 || stats.Prefetched != 1 || stats.PrefetchHits != 1 || stats.PrefetchHitRate() != 1 {
+		t.Fatalf("Cache stats are incorrect: %+v", stats)
+	}
+}
+
+func TestAuthTokenCheckIsNotCached(t *testing.T) {
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"search": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			return &pb.ResponseWrapper{Payload: &pb.Payload{SearchResponse: &pb.SearchResponse{}}}, nil
+		},
+	}}
+	client := createFakePlayStoreClient(t, store, &Config{Cache: NewMemoryCache(0), CacheTTL: time.Hour})
+
+	if _, err := client.Search(""); err != nil {
+		t.Fatal(err)
+	}
+	if !client.IsValidAuthToken() {
+		t.Fatal("Token should be valid")
+	}
+
+	// Expired token
+	store.responses["search"] = func(req *http.Request) (*pb.ResponseWrapper, error) {
+		return nil, errors.New("401 Unauthorized")
+	}
+	if client.IsValidAuthToken() {
+		t.Fatal("Cached search response should not hide an invalid token")
+	}
+}
+
+func TestCacheKeyAccount(t *testing.T) {
+	var keys []string
+	for _, token := range []string{"token-a", "token-b"} {
+		authClient, err := auth.CreatePlaystoreAuthClient(&auth.Config{GsfId: "1", AuthSubToken: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, (&Client{authClient: authClient}).cacheKey("details?doc="+TestPackageName))
+	}
+
+	if keys[0] == keys[1] {
+		t.Fatalf("Accounts on the same device should not share cache keys: %s", keys[0])
+	}
+}
@@ -2,6 +2,7 @@ package playstore
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -16,7 +17,8 @@ import (
 	"net/url"
 	"os"
 	"path"
-	"strconv"
+	"sync"
+	"time"
 )
 
 const (
@@ -25,14 +27,35 @@ const (
 	TocUrl      = FDFEUrl + "toc"
 	DetailsUrl  = FDFEUrl + "details"
 	PurchaseUrl = FDFEUrl + "purchase"
+	ListUrl     = FDFEUrl + "list"
 )
 
 type Client struct {
 	authClient *auth.Client
+	locale     string
+	cache      ResponseCache
+	cacheTTL   time.Duration
+	deviceSpec *DeviceSpec
+	// Transport of the API requests, the hystrix client is used if nil
+	transport http.RoundTripper
+
+	statsMutex sync.Mutex
+	cacheStats CacheStats
+
+	notificationMutex    sync.Mutex
+	notificationHandlers []NotificationHandler
 }
 
 type Config struct {
 	AuthConfig *auth.Config
+	// Language of the responses, e.g., "en-US". Server default if empty
+	Locale string
+	// Cache for details, search and list responses. Responses are not cached if nil
+	Cache ResponseCache
+	// How long responses without server TTL are cached, these are not cached if zero
+	CacheTTL time.Duration
+	// Only the splits matching the device are delivered, all splits the server returns if nil
+	DeviceSpec *DeviceSpec
 }
 
 func CreatePlaystoreClient(config *Config) (*Client, error) {
@@ -43,70 +66,237 @@ func CreatePlaystoreClient(config *Config) (*Client, error) {
 
 	return &Client{
 		authClient: authedClient,
+		locale:     config.Locale,
+		cache:      config.Cache,
+		cacheTTL:   config.CacheTTL,
+		deviceSpec: config.DeviceSpec,
 	}, nil
 }
 
 func (client *Client) send(url string, bodyParams *url.Values) (*pb.ResponseWrapper, error) {
+	if bodyParams == nil {
+		return client.sendBody(url, "", nil)
+	}
+	return client.sendBody(url, "application/x-www-form-urlencoded", []byte(bodyParams.Encode()))
+}
+
+// POST protobuf message
+func (client *Client) sendProto(url string, msg proto.Message) (*pb.ResponseWrapper, error) {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return client.sendBody(url, "application/x-protobuf", body)
+}
+
+// GET if `body` is nil, otherwise POST `body` with `contentType`
+func (client *Client) sendBody(url string, contentType string, body []byte) (*pb.ResponseWrapper, error) {
+	responseWrapper, _, err := client.doRequest(url, contentType, body, "")
+	return responseWrapper, err
+}
+
+/**
+GET using the response cache, for metadata like details, search and lists.
+Stale responses with etag are revalidated
+*/
+func (client *Client) sendCacheable(url string) (*pb.ResponseWrapper, error) {
+	if client.cache == nil {
+		return client.send(url, nil)
+	}
+
+	key := client.cacheKey(url)
+	now := time.Now()
+
+	entry, ok := client.cache.Get(key)
+	if ok && entry.isExpired(now) {
+		client.cache.Delete(key)
+		ok = false
+	}
+
+	if ok && entry.isFresh(now) {
+		log.Debugf("GET %s (cached)", url)
+		client.countCacheHit(key, entry, false)
+		return entry.Response, nil
+	}
+
+	etag := ""
+	if ok {
+		etag = entry.Etag
+	}
+
+	responseWrapper, res, err := client.doRequest(url, "", nil, etag)
+	if err != nil {
+		return responseWrapper, err
+	}
+
+	// Not modified
+	if responseWrapper == nil {
+		log.Debugf("Cached response of %s is still valid", url)
+		responseWrapper = entry.Response
+		client.countCacheHit(key, entry, true)
+	} else {
+		client.updateCacheStats(func(stats *CacheStats) { stats.Misses++ })
+	}
+
+	if newEntry := client.responseCacheEntry(responseWrapper, res.Header, etag, now); newEntry != nil {
+		client.cache.Set(key, newEntry)
+	}
+	return responseWrapper, nil
+}
+
+// Prefetched entry is marked used, so it is counted only once
+func (client *Client) countCacheHit(key string, entry *CacheEntry, revalidated bool) {
+	client.updateCacheStats(func(stats *CacheStats) {
+		stats.Hits++
+		if revalidated {
+			stats.Revalidated++
+		}
+		if entry.Prefetched {
+			stats.PrefetchHits++
+		}
+	})
+
+	// Revalidated entries are replaced
+	if entry.Prefetched && !revalidated {
+		entry.Prefetched = false
+		client.cache.Set(key, entry)
+	}
+}
+
+func (client *Client) updateCacheStats(update func(stats *CacheStats)) {
+	client.statsMutex.Lock()
+	defer client.statsMutex.Unlock()
+	update(&client.cacheStats)
+}
+
+/**
+Response cache usage since the client was created, including how many prefetched responses were used
+*/
+func (client *Client) CacheStats() CacheStats {
+	client.statsMutex.Lock()
+	defer client.statsMutex.Unlock()
+	return client.cacheStats
+}
+
+// Responses depend on the device, account and language.
+// The account is identified by a hash of its token, so the token is not kept in the cache
+func (client *Client) cacheKey(url string) string {
+	account := sha256.Sum256([]byte(client.authClient.GetAuthSubToken()))
+	return fmt.Sprintf("%s|%x|%s|%s", client.authClient.GetGsfId(), account[:8], client.locale, url)
+}
+
+// Nil if the response should not be cached
+func (client *Client) responseCacheEntry(
+	responseWrapper *pb.ResponseWrapper, header http.Header, etag string, now time.Time) *CacheEntry {
+
+	ttl := parseTTLHeader(header.Get(hardTTLHeader))
+	softTTL := parseTTLHeader(header.Get(softTTLHeader))
+	if ttl == 0 {
+		ttl = int64(client.cacheTTL / time.Millisecond)
+	}
+	if ttl == 0 {
+		return nil
+	}
+
+	if header.Get("ETag") != "" {
+		etag = header.Get("ETag")
+	}
+	return newCacheEntry(responseWrapper, etag, now, ttl, softTTL)
+}
+
+/**
+Remove all cached responses
+*/
+func (client *Client) ClearCache() {
+	if client.cache != nil {
+		client.cache.Clear()
+	}
+}
+
+/**
+Send the request, if `etag` is set the request is conditional.
+The response wrapper is nil if the server responds Not Modified
+*/
+func (client *Client) doRequest(
+	url string, contentType string, body []byte, etag string) (*pb.ResponseWrapper, *http.Response, error) {
 	// Do auth if needed
 	if !client.authClient.HasAuthToken() {
 		if err := client.authClient.Authenticate(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	var body io.Reader
+	var bodyReader io.Reader
 
 	method := "GET"
-	if bodyParams != nil {
+	if body != nil {
 		method = "POST"
-		body = bytes.NewBufferString(bodyParams.Encode())
+		bodyReader = bytes.NewReader(body)
 	}
 
 	log.Debugf("%s %s", method, url)
 
-	httpClient, err := createHTTPClient()
+	httpClient, err := createHTTPClient(client.transport)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequest(method, url, bodyReader)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req.Header.Set("X-DFE-Device-Id", client.authClient.GetGsfId())
 	req.Header.Set("Authorization", fmt.Sprintf(
 		"GoogleLogin auth=%s", client.authClient.GetAuthSubToken()))
 
-	if bodyParams != nil {
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if client.locale != "" {
+		req.Header.Set("Accept-Language", client.locale)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
 	}
 
 	reqRes, err := httpDoRetryOnNotFound(httpClient, req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	defer reqRes.Body.Close()
+
+	if etag != "" && reqRes.StatusCode == http.StatusNotModified {
+		return nil, reqRes, nil
 	}
 
 	data, err := ioutil.ReadAll(reqRes.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var responseWrapper pb.ResponseWrapper
 	err = proto.Unmarshal(data, &responseWrapper)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	client.handleNotifications(&responseWrapper)
 
 	if reqRes.StatusCode != 200 {
-		return &responseWrapper, fmt.Errorf("unexpected response for %s: %s",
+		return &responseWrapper, reqRes, fmt.Errorf("unexpected response for %s: %s",
 			url, reqRes.Status)
 	}
 
+	if responseWrapper.GetCommands().GetClearCache() {
+		log.Debug("Server requested clearing the cache")
+		client.ClearCache()
+	}
+	client.storePrefetched(&responseWrapper)
+
 	if responseWrapper.Commands != nil && responseWrapper.Commands.DisplayErrorMessage != nil {
-		return &responseWrapper, errors.New(*responseWrapper.Commands.DisplayErrorMessage)
+		return &responseWrapper, reqRes, errors.New(*responseWrapper.Commands.DisplayErrorMessage)
 	}
-	return &responseWrapper, nil
+	return &responseWrapper, reqRes, nil
 }
 
 func (client *Client) GetAuthClient() *auth.Client {
@@ -115,7 +305,7 @@ func (client *Client) GetAuthClient() *auth.Client {
 
 // c param is content type, 0=book global?, 1=book, 3=app, 4=video
 func (client *Client) Search(query string) (*pb.SearchResponse, error) {
-	resWrap, err := client.send(fmt.Sprintf("%s?c=3&q=%s", SearchUrl, query), nil)
+	resWrap, err := client.sendCacheable(fmt.Sprintf("%s?c=3&q=%s", SearchUrl, query))
 	if err != nil {
 		return nil, err
 	}
@@ -126,79 +316,123 @@ func (client *Client) Search(query string) (*pb.SearchResponse, error) {
 Get app details by its package name
 */
 func (client *Client) GetDetails(packageName string) (*pb.DocV2, error) {
-
-	resWrap, err := client.send(fmt.Sprintf("%s?doc=%s", DetailsUrl, packageName), nil)
+	detailsRes, err := client.GetDetailsResponse(packageName)
 	if err != nil {
 		return nil, err
 	}
-	return resWrap.Payload.DetailsResponse.DocV2, nil
+	return detailsRes.DocV2, nil
 }
 
-func (client *Client) Purchase(packageName string, versionCode int) (*pb.BuyResponse, error) {
-	params := &url.Values{}
-	params.Set("ot", "1")
-	params.Set("doc", packageName)
-	params.Set("vc", strconv.Itoa(versionCode))
+func detailsUrl(packageName string) string {
+	return fmt.Sprintf("%s?doc=%s", DetailsUrl, packageName)
+}
 
-	res, err := client.send(PurchaseUrl, params)
+/**
+Get the full details response, which contains also the legacy DocV1 and its list links
+*/
+func (client *Client) GetDetailsResponse(packageName string) (*pb.DetailsResponse, error) {
+	resWrap, err := client.sendCacheable(detailsUrl(packageName))
 	if err != nil {
-		log.Errorf("Purchase error: %v, %v", res, err)
 		return nil, err
 	}
-	return res.Payload.BuyResponse, nil
+
+	detailsRes := resWrap.GetPayload().GetDetailsResponse()
+	if detailsRes == nil || detailsRes.DocV2 == nil {
+		return nil, fmt.Errorf("response does not contain details for %s", packageName)
+	}
+	return detailsRes, nil
+}
+
+/**
+"Purchase" the app using the default offer type, see `PurchaseOffer`
+*/
+func (client *Client) Purchase(packageName string, versionCode int) (*pb.BuyResponse, error) {
+	return client.PurchaseOffer(packageName, versionCode, defaultOfferType)
 }
 
 /**
 Get app delivery data (download URL) for application from playstore
 
-In order to download the app, the app is "purchased" first
+Apps the account owns are delivered directly. Otherwise free apps are "purchased" first,
+paid apps return PaymentRequiredError
 If `versionCode` is zero, get delivery data for the latest version
 */
 func (client *Client) GetAppDeliveryData(packageName string, versionCode int) (*pb.AndroidAppDeliveryData, error) {
+	appDeliveryData, _, err := client.getAppDeliveryData(packageName, versionCode)
+	return appDeliveryData, err
+}
+
+// Returns also the delivered version code, which is the latest version if `versionCode` is zero
+func (client *Client) getAppDeliveryData(
+	packageName string, versionCode int) (*pb.AndroidAppDeliveryData, int, error) {
+
 	log.Debugf("Get delivery data for %s", packageName)
 
+	doc, err := client.GetDetails(packageName)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Get latest version code
 	if versionCode == 0 {
-		doc, err := client.GetDetails(packageName)
-		if err != nil {
-			return nil, err
-		}
-
-		if doc.Details.AppDetails.VersionCode == nil {
-			return nil, fmt.Errorf("App details did not contain version code. " +
+		if doc.GetDetails().GetAppDetails().VersionCode == nil {
+			return nil, 0, fmt.Errorf("App details did not contain version code. " +
 				"Is the gsfId correct, does the app support the specified device config?")
 		}
-		versionCode = int(*doc.Details.AppDetails.VersionCode)
+		versionCode = int(doc.GetDetails().GetAppDetails().GetVersionCode())
 
 		log.Debugf("Latest %s version code: %d", packageName, versionCode)
 	}
 
-	buyRes, err := client.Purchase(packageName, versionCode)
+	offer := selectOffer(doc)
+
+	appDeliveryData, err := client.Delivery(packageName, versionCode, &DeliveryOptions{OfferType: offerType(offer)})
+	if err == nil {
+		return appDeliveryData, versionCode, nil
+	}
+	// Network, auth and server errors are not a reason to acquire the app
+	if err != ErrNotDelivered {
+		return nil, 0, err
+	}
+	log.Debugf("%s was not delivered, the account does not own it", packageName)
+
+	if offer.GetMicros() > 0 {
+		return nil, 0, &PaymentRequiredError{PackageName: packageName, Price: offerPrice(offer)}
+	}
+
+	buyRes, err := client.PurchaseOffer(packageName, versionCode, offerType(offer))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	purchaseStatusRes := buyRes.PurchaseStatusResponse
-	if purchaseStatusRes == nil {
-		return nil, fmt.Errorf("response does not contain purchase status response")
+	if appDeliveryData := buyRes.GetPurchaseStatusResponse().GetAppDeliveryData(); appDeliveryData != nil {
+		client.selectSplits(appDeliveryData)
+		return appDeliveryData, versionCode, nil
 	}
 
-	appDeliveryData := purchaseStatusRes.AppDeliveryData
-	if appDeliveryData == nil {
-		return nil, fmt.Errorf("response does not contain app delivery data")
+	// Purchase may return only a token for fetching the delivery data
+	if buyRes.GetDownloadToken() == "" {
+		return nil, 0, fmt.Errorf("response does not contain app delivery data")
 	}
-	return appDeliveryData, nil
+	appDeliveryData, err = client.Delivery(packageName, versionCode, &DeliveryOptions{
+		OfferType:     offerType(offer),
+		DownloadToken: buyRes.GetDownloadToken(),
+	})
+	return appDeliveryData, versionCode, err
 }
 
 type DownloadInfo struct {
-	Url    string
-	Sha1   []byte
-	Sha256 []byte
-	Size   int64
+	PackageName string
+	// The delivered version, the latest version if the download did not specify version
+	VersionCode int
+	Url         string
+	Sha1        []byte
+	Sha256      []byte
+	Size        int64
 }
 
 func (client *Client) GetAppDownloadInfo(packageName string, versionCode int) (*DownloadInfo, error) {
-	deliveryData, err := client.GetAppDeliveryData(packageName, versionCode)
+	deliveryData, versionCode, err := client.getAppDeliveryData(packageName, versionCode)
 	if err != nil {
 		return nil, err
 	}
@@ -223,10 +457,12 @@ func (client *Client) GetAppDownloadInfo(packageName string, versionCode int) (*
 	}
 
 	return &DownloadInfo{
-		Url:    downloadUrl,
-		Sha1:   sha1Checksum,
-		Sha256: sha256Checksum,
-		Size:   *deliveryData.DownloadSize,
+		PackageName: packageName,
+		VersionCode: versionCode,
+		Url:         downloadUrl,
+		Sha1:        sha1Checksum,
+		Sha256:      sha256Checksum,
+		Size:        *deliveryData.DownloadSize,
 	}, nil
 }
 
@@ -268,13 +504,14 @@ func (client *Client) Download(packageName string, versionCode int) (io.ReadClos
 	log.Debugf("Downloading %s from %s", packageName, info.Url)
 
 	reader, err := DownloadVerifySha256(info.Url, info.Size, info.Sha256)
-	return reader, info,err
+	return reader, info, err
 }
 
 /**
 Check if the client has valid auth creds to the playstore
 */
 func (client *Client) IsValidAuthToken() bool {
-	_, err := client.Search("")
+	// Not cached, a cached response would hide an expired token
+	_, err := client.send(fmt.Sprintf("%s?c=3&q=", SearchUrl), nil)
 	return err == nil
 }
@@ -1,8 +1,17 @@
 package playstore
 
 import (
+	"bytes"
+	"errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/jarijaas/go-gplayapi/pkg/auth"
+	"github.com/jarijaas/go-gplayapi/pkg/keyring"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -29,6 +38,78 @@ func createPlayStoreTestClient(t *testing.T) *Client {
 	return client
 }
 
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Fake playstore API, responds by the last path element of the URL, e.g., "details"
+type fakePlayStore struct {
+	mutex     sync.Mutex
+	responses map[string]func(req *http.Request) (*pb.ResponseWrapper, error)
+	// Requested URLs
+	requests []string
+}
+
+func (store *fakePlayStore) roundTrip(req *http.Request) (*http.Response, error) {
+	store.mutex.Lock()
+	store.requests = append(store.requests, req.URL.String())
+	respond := store.responses[req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]]
+	store.mutex.Unlock()
+
+	if respond == nil {
+		return &http.Response{StatusCode: http.StatusInternalServerError, Status: "500 Internal Server Error",
+			Body: ioutil.NopCloser(bytes.NewReader(nil)), Header: http.Header{}}, nil
+	}
+
+	res, err := respond(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{StatusCode: http.StatusOK, Status: "200 OK",
+		Body: ioutil.NopCloser(bytes.NewReader(data)), Header: http.Header{}}, nil
+}
+
+func (store *fakePlayStore) requested(path string) int {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	count := 0
+	for _, url := range store.requests {
+		if strings.Contains(url, path) {
+			count++
+		}
+	}
+	return count
+}
+
+func createFakePlayStoreClient(t *testing.T, store *fakePlayStore, config *Config) *Client {
+	if config == nil {
+		config = &Config{}
+	}
+	config.AuthConfig = &auth.Config{GsfId: "123", AuthSubToken: "token", TokenStore: keyring.NewMemoryStore()}
+
+	client, err := CreatePlaystoreClient(config)
+	if err != nil {
+		t.Fatalf("Could not create playstore client: %v", err)
+	}
+	client.transport = roundTripFunc(store.roundTrip)
+	return client
+}
+
+func detailsResponse(packageName string, versionCode int32, offers ...*pb.Offer) *pb.ResponseWrapper {
+	return &pb.ResponseWrapper{Payload: &pb.Payload{DetailsResponse: &pb.DetailsResponse{DocV2: &pb.DocV2{
+		Docid:   proto.String(packageName),
+		Details: &pb.DocumentDetails{AppDetails: &pb.AppDetails{VersionCode: proto.Int32(versionCode)}},
+		Offer:   offers,
+	}}}}
+}
+
 func TestGetAppDetails(t *testing.T) {
 	client := createPlayStoreTestClient(t)
 
@@ -57,4 +138,98 @@ func TestDownloadApp(t *testing.T) {
 	if res.DownloadUrl == nil {
 		t.Fatalf("%s delivery data does not have download URL: %v ", TestPackageName, res)
 	}
-}
\ No newline at end of file
+}
+
+func TestGetRelatedLists(t *testing.T) {
+	client := createPlayStoreTestClient(t)
+
+	lists, err := client.GetRelatedLists(TestPackageName)
+	if err != nil {
+		t.Fatalf("Could not get related lists: %v", err)
+	}
+
+	if len(lists) == 0 {
+		t.Fatalf("%s does not have related lists", TestPackageName)
+	}
+
+	apps, err := client.GetAppList(lists[0].Url)
+	if err != nil {
+		t.Fatalf("Could not get related apps: %v", err)
+	}
+
+	if len(apps) == 0 {
+		t.Fatalf("Related list %s is empty", lists[0].Label)
+	}
+}
+
+func TestSelectOffer(t *testing.T) {
+	paid := &pb.Offer{Micros: proto.Int64(990000), OfferType: proto.Int32(1)}
+	trial := &pb.Offer{Micros: proto.Int64(0), OfferType: proto.Int32(7)}
+
+	if offer := selectOffer(&pb.DocV2{Offer: []*pb.Offer{paid, trial}}); offer != trial {
+		t.Fatalf("Free offer should be preferred, got: %v", offer)
+	}
+
+	if offer := selectOffer(&pb.DocV2{Offer: []*pb.Offer{paid}}); offer != paid {
+		t.Fatalf("Only offer should be selected, got: %v", offer)
+	}
+
+	if ot := offerType(selectOffer(&pb.DocV2{})); ot != defaultOfferType {
+		t.Fatalf("Offer type should default to %d, got: %d", defaultOfferType, ot)
+	}
+}
+
+func TestDeliveryOwnedApp(t *testing.T) {
+	client := createPlayStoreTestClient(t)
+
+	// Acquires the app, if the account does not own it yet
+	_, err := client.GetAppDeliveryData(TestPackageName, 0)
+	if err != nil {
+		t.Fatalf("Could not purchase app: %v", err)
+	}
+
+	res, err := client.Delivery(TestPackageName, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not get delivery data for owned app: %v", err)
+	}
+
+	if res.DownloadUrl == nil {
+		t.Fatalf("%s delivery data does not have download URL: %v ", TestPackageName, res)
+	}
+}
+
+func TestDeliveryErrorIsNotPurchase(t *testing.T) {
+	transportErr := errors.New("connection reset")
+	paid := &pb.Offer{Micros: proto.Int64(990000), OfferType: proto.Int32(1)}
+
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"details": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			return detailsResponse(TestPackageName, 10, paid), nil
+		},
+		"delivery": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			return nil, transportErr
+		},
+	}}
+	client := createFakePlayStoreClient(t, store, nil)
+
+	_, err := client.GetAppDeliveryData(TestPackageName, 0)
+	if err == nil || !strings.Contains(err.Error(), transportErr.Error()) {
+		t.Fatalf("Transport error was not returned: %v", err)
+	}
+	if _, ok := err.(*PaymentRequiredError); ok {
+		t.Fatalf("Transport error should not be payment required")
+	}
+
+	// Not delivered, so the account does not own the paid app
+	store.responses["delivery"] = func(req *http.Request) (*pb.ResponseWrapper, error) {
+		return &pb.ResponseWrapper{Payload: &pb.Payload{DeliveryResponse: &pb.DeliveryResponse{}}}, nil
+	}
+	if _, err = client.GetAppDeliveryData(TestPackageName, 0); err == nil {
+		t.Fatalf("Not owned paid app should not be delivered")
+	} else if _, ok := err.(*PaymentRequiredError); !ok {
+		t.Fatalf("Not owned paid app should return payment required: %v", err)
+	}
+	if store.requested("purchase") != 0 {
+		t.Fatalf("Paid app should not be purchased")
+	}
+}
@@ -0,0 +1,94 @@
+package playstore
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"net/url"
+	"strconv"
+)
+
+const DeliveryUrl = FDFEUrl + "delivery"
+
+// Returned by Delivery when the app is not delivered, usually because the account does not own it
+var ErrNotDelivered = errors.New("response does not contain app delivery data")
+
+type DeliveryOptions struct {
+	// Offer type the app was acquired with, default offer type if zero
+	OfferType int
+	// Names of the splits to deliver, e.g., "config.arm64_v8a". If empty, the splits matching
+	// the device spec of the client or all splits
+	SplitNames []string
+	// Download token from a purchase (BuyResponse.downloadToken)
+	DownloadToken string
+}
+
+/**
+Get delivery data (download URLs) of an app the account already owns
+
+Unlike purchase, does not modify the library. Returns ErrNotDelivered if the account does not own the app
+If `versionCode` is zero, get delivery data for the latest version
+*/
+func (client *Client) Delivery(packageName string, versionCode int, opts *DeliveryOptions) (*pb.AndroidAppDeliveryData, error) {
+	if opts == nil {
+		opts = &DeliveryOptions{}
+	}
+
+	offerType := opts.OfferType
+	if offerType == 0 {
+		offerType = defaultOfferType
+	}
+
+	params := url.Values{}
+	params.Set("ot", strconv.Itoa(offerType))
+	params.Set("doc", packageName)
+	if versionCode != 0 {
+		params.Set("vc", strconv.Itoa(versionCode))
+	}
+	if opts.DownloadToken != "" {
+		params.Set("dtok", opts.DownloadToken)
+	}
+	for _, splitName := range opts.SplitNames {
+		params.Add("mn", splitName)
+	}
+
+	res, err := client.send(fmt.Sprintf("%s?%s", DeliveryUrl, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appDeliveryData := res.GetPayload().GetDeliveryResponse().GetAppDeliveryData()
+	if appDeliveryData == nil {
+		return nil, ErrNotDelivered
+	}
+
+	// The server may deliver more splits than requested
+	if len(opts.SplitNames) > 0 {
+		appDeliveryData.Split = filterSplitsByName(appDeliveryData.Split, opts.SplitNames)
+	} else {
+		client.selectSplits(appDeliveryData)
+	}
+	return appDeliveryData, nil
+}
+
+// Remove the splits not matching the device spec of the client
+func (client *Client) selectSplits(appDeliveryData *pb.AndroidAppDeliveryData) {
+	if client.deviceSpec != nil {
+		appDeliveryData.Split = client.deviceSpec.SelectSplits(appDeliveryData.Split)
+	}
+}
+
+func filterSplitsByName(splits []*pb.Split, names []string) []*pb.Split {
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var filtered []*pb.Split
+	for _, split := range splits {
+		if wanted[split.GetName()] {
+			filtered = append(filtered, split)
+		}
+	}
+	return filtered
+}
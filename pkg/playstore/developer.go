@@ -0,0 +1,58 @@
+package playstore
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+/**
+Get all apps published by a developer
+
+`developerId` is either the numeric developer id, as in https://play.google.com/store/apps/dev?id=...,
+or the developer name as shown on the app page
+*/
+func (client *Client) DeveloperApps(developerId string) ([]*pb.DocV2, error) {
+	if _, err := strconv.ParseUint(developerId, 10, 64); err == nil {
+		listUrl, err := developerAppsUrl(developerId)
+		if err != nil {
+			return nil, err
+		}
+		return client.getAppList(listUrl)
+	}
+
+	apps, err := client.getAppList(fmt.Sprintf("%s?c=3&q=%s", SearchUrl,
+		url.QueryEscape(fmt.Sprintf("pub:\"%s\"", developerId))))
+	if err != nil {
+		return nil, err
+	}
+
+	// Search matches also developers with similar names
+	var developerApps []*pb.DocV2
+	for _, app := range apps {
+		if strings.EqualFold(app.GetCreator(), developerId) {
+			developerApps = append(developerApps, app)
+		}
+	}
+	return developerApps, nil
+}
+
+// The developer page is requested by passing an encoded UrlRequestWrapper to the list endpoint
+func developerAppsUrl(developerId string) (string, error) {
+	req := &pb.UrlRequestWrapper{
+		DeveloperAppsRequest: &pb.DeveloperAppsRequest{
+			DeveloperIdContainer1: &pb.DeveloperIdContainer{DeveloperId: proto.String(developerId)},
+			DeveloperIdContainer2: &pb.DeveloperIdContainer{DeveloperId: proto.String(developerId)},
+		},
+	}
+
+	rawReq, err := proto.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?c=3&urwr=%s", ListUrl, base64.RawURLEncoding.EncodeToString(rawReq)), nil
+}
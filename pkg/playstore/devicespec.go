@@ -0,0 +1,236 @@
+package playstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+/**
+Device the split APKs are selected for, in the format of bundletool device-spec.json
+
+The splits do not depend on the SDK version, it is kept for compatibility with bundletool
+*/
+type DeviceSpec struct {
+	// In order of preference, e.g., "arm64-v8a", "armeabi-v7a"
+	SupportedAbis []string `json:"supportedAbis"`
+	// E.g., "en-US" or "de"
+	SupportedLocales []string `json:"supportedLocales"`
+	// Dots per inch, e.g., 420
+	ScreenDensity  int      `json:"screenDensity"`
+	SdkVersion     int      `json:"sdkVersion,omitempty"`
+	DeviceFeatures []string `json:"deviceFeatures,omitempty"`
+	GlExtensions   []string `json:"glExtensions,omitempty"`
+}
+
+/**
+Load device spec from a bundletool device-spec.json or a JSON DeviceConfigurationProto,
+e.g., {"nativePlatform": ["arm64-v8a"], "screenDensity": 420, "systemSupportedLocale": ["en_US"]}
+*/
+func LoadDeviceSpec(path string) (*DeviceSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("could not parse device spec: %v", err)
+	}
+
+	if _, ok := fields["supportedAbis"]; ok {
+		spec := &DeviceSpec{}
+		if err = json.Unmarshal(data, spec); err != nil {
+			return nil, fmt.Errorf("could not parse device spec: %v", err)
+		}
+		return spec, nil
+	}
+
+	config := &pb.DeviceConfigurationProto{}
+	if err = protojson.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("could not parse device configuration: %v", err)
+	}
+	return DeviceSpecFromConfig(config, 0), nil
+}
+
+/**
+Device spec of the device configuration sent in checkin, the SDK version is not part of the configuration
+*/
+func DeviceSpecFromConfig(config *pb.DeviceConfigurationProto, sdkVersion int) *DeviceSpec {
+	return &DeviceSpec{
+		SupportedAbis:    config.GetNativePlatform(),
+		SupportedLocales: config.GetSystemSupportedLocale(),
+		ScreenDensity:    int(config.GetScreenDensity()),
+		SdkVersion:       sdkVersion,
+		DeviceFeatures:   config.GetSystemAvailableFeature(),
+		GlExtensions:     config.GetGlExtension(),
+	}
+}
+
+// Density buckets of the density splits, e.g., "config.xxhdpi"
+var splitDensities = map[string]int{
+	"ldpi":    120,
+	"mdpi":    160,
+	"tvdpi":   213,
+	"hdpi":    240,
+	"xhdpi":   320,
+	"xxhdpi":  480,
+	"xxxhdpi": 640,
+}
+
+// Parsed name of a configuration split, "<module>.config.<value>" or "config.<value>" for the base module
+type configSplit struct {
+	split  *pb.Split
+	module string
+	value  string
+}
+
+/**
+Select the configuration splits matching the device, other splits are kept
+
+Of the ABI splits of each module, the split of the most preferred ABI is selected.
+Of the density splits, the smallest density at least the screen density, or the largest if none is.
+Language splits are selected if the language is one of the supported locales.
+All ABI, density or language splits are kept, if the spec does not have ABIs, density or locales
+Unknown configuration splits, e.g., texture formats, are kept
+*/
+func (spec *DeviceSpec) SelectSplits(splits []*pb.Split) []*pb.Split {
+	languages := map[string]bool{}
+	for _, locale := range spec.SupportedLocales {
+		languages[localeLanguage(locale)] = true
+	}
+
+	var selected []*pb.Split
+	abiSplits := map[string][]configSplit{}
+	densitySplits := map[string][]configSplit{}
+
+	for _, split := range splits {
+		config, ok := parseConfigSplit(split)
+		switch {
+		case !ok:
+			selected = append(selected, split)
+		case isAbi(config.value):
+			abiSplits[config.module] = append(abiSplits[config.module], config)
+		case splitDensities[config.value] > 0:
+			densitySplits[config.module] = append(densitySplits[config.module], config)
+		case isLanguage(config.value):
+			if len(languages) == 0 || languages[config.value] {
+				selected = append(selected, split)
+			}
+		default:
+			selected = append(selected, split)
+		}
+	}
+
+	for _, configs := range abiSplits {
+		selected = append(selected, spec.selectAbi(configs)...)
+	}
+	for _, configs := range densitySplits {
+		selected = append(selected, spec.selectDensity(configs)...)
+	}
+
+	// Keep the delivered order
+	order := map[*pb.Split]int{}
+	for i, split := range splits {
+		order[split] = i
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return order[selected[i]] < order[selected[j]]
+	})
+	return selected
+}
+
+// Nil if the device does not support any of the ABIs, all splits if the ABIs are unknown
+func (spec *DeviceSpec) selectAbi(configs []configSplit) []*pb.Split {
+	if len(spec.SupportedAbis) == 0 {
+		return configSplits(configs)
+	}
+
+	for _, abi := range spec.SupportedAbis {
+		for _, config := range configs {
+			if config.value == strings.ReplaceAll(abi, "-", "_") {
+				return []*pb.Split{config.split}
+			}
+		}
+	}
+	return nil
+}
+
+// All splits if the screen density is unknown
+func (spec *DeviceSpec) selectDensity(configs []configSplit) []*pb.Split {
+	if spec.ScreenDensity <= 0 {
+		return configSplits(configs)
+	}
+
+	var best *configSplit
+	for i := range configs {
+		config := &configs[i]
+		if best == nil || betterDensity(splitDensities[config.value], splitDensities[best.value], spec.ScreenDensity) {
+			best = config
+		}
+	}
+	return []*pb.Split{best.split}
+}
+
+func configSplits(configs []configSplit) []*pb.Split {
+	splits := make([]*pb.Split, len(configs))
+	for i, config := range configs {
+		splits[i] = config.split
+	}
+	return splits
+}
+
+// Higher densities are scaled down, which looks better than scaling lower densities up
+func betterDensity(density int, best int, screenDensity int) bool {
+	if (density >= screenDensity) != (best >= screenDensity) {
+		return density >= screenDensity
+	}
+	if density >= screenDensity {
+		return density < best
+	}
+	return density > best
+}
+
+func parseConfigSplit(split *pb.Split) (configSplit, bool) {
+	name := split.GetName()
+	if strings.HasPrefix(name, "config.") {
+		return configSplit{split: split, module: BaseApkName, value: strings.TrimPrefix(name, "config.")}, true
+	}
+	if i := strings.Index(name, ".config."); i >= 0 {
+		return configSplit{split: split, module: name[:i], value: name[i+len(".config."):]}, true
+	}
+	return configSplit{}, false
+}
+
+func isAbi(value string) bool {
+	switch value {
+	case "armeabi", "armeabi_v7a", "arm64_v8a", "x86", "x86_64", "mips", "mips64":
+		return true
+	}
+	return false
+}
+
+// Language splits are named by the two or three letter language code, e.g., "config.de"
+func isLanguage(value string) bool {
+	if len(value) < 2 || len(value) > 3 {
+		return false
+	}
+	for _, c := range value {
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// E.g., "de" of "de-DE" or "de_DE"
+func localeLanguage(locale string) string {
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(locale)
+}
@@ -0,0 +1,87 @@
+package playstore
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSelectSplits(t *testing.T) {
+	var splits []*pb.Split
+	for _, name := range []string{
+		"config.arm64_v8a", "config.armeabi_v7a", "config.x86_64",
+		"config.hdpi", "config.xhdpi", "config.xxhdpi", "config.xxxhdpi",
+		"config.de", "config.en", "config.fr",
+		"feature", "feature.config.armeabi_v7a", "feature.config.astc",
+	} {
+		splits = append(splits, &pb.Split{Name: proto.String(name)})
+	}
+
+	spec := &DeviceSpec{
+		SupportedAbis:    []string{"arm64-v8a", "armeabi-v7a"},
+		SupportedLocales: []string{"en-US", "fr_FR"},
+		ScreenDensity:    420,
+	}
+
+	var names []string
+	for _, split := range spec.SelectSplits(splits) {
+		names = append(names, split.GetName())
+	}
+
+	expected := []string{"config.arm64_v8a", "config.xxhdpi", "config.en", "config.fr",
+		"feature", "feature.config.armeabi_v7a", "feature.config.astc"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Selected splits %v, expected %v", names, expected)
+	}
+
+	// Screen denser than any split
+	spec.ScreenDensity = 800
+	if selected := spec.SelectSplits(splits[3:7]); len(selected) != 1 || selected[0].GetName() != "config.xxxhdpi" {
+		t.Fatalf("Highest density should be selected: %v", selected)
+	}
+
+	// Unknown ABIs and density keep the splits, like unknown locales
+	spec = &DeviceSpec{SupportedLocales: []string{"en-US"}}
+	names = nil
+	for _, split := range spec.SelectSplits(splits) {
+		names = append(names, split.GetName())
+	}
+
+	expected = []string{"config.arm64_v8a", "config.armeabi_v7a", "config.x86_64",
+		"config.hdpi", "config.xhdpi", "config.xxhdpi", "config.xxxhdpi", "config.en",
+		"feature", "feature.config.armeabi_v7a", "feature.config.astc"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Selected splits without ABIs and density %v, expected %v", names, expected)
+	}
+}
+
+func TestLoadDeviceSpec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gplay-devicespec")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, data := range map[string]string{
+		"device-spec.json": `{"supportedAbis": ["arm64-v8a"], "supportedLocales": ["en-US"], "screenDensity": 420, "sdkVersion": 30}`,
+		"config.json":      `{"nativePlatform": ["arm64-v8a"], "systemSupportedLocale": ["en-US"], "screenDensity": 420}`,
+	} {
+		path := filepath.Join(dir, name)
+		if err = ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		spec, err := LoadDeviceSpec(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(spec.SupportedAbis) != 1 || spec.SupportedAbis[0] != "arm64-v8a" || spec.ScreenDensity != 420 ||
+			len(spec.SupportedLocales) != 1 {
+			t.Fatalf("Device spec of %s is incorrect: %+v", name, spec)
+		}
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"crypto/sha1"
 	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
 	"hash"
 	"io"
 	"net/http"
+	"sort"
 )
 
 // DownloadFile downloads a file and write it to disk during download
@@ -84,3 +87,141 @@ func DownloadVerify(url string, downloadSize int64, hashFunc hash.Hash, checksum
 	}()
 	return pr, nil
 }
+
+// Name of the base APK in DeliveryFiles
+const BaseApkName = "base"
+
+type DeliveryFileType int
+
+const (
+	DeliveryFileApk DeliveryFileType = iota
+	// APK expansion file, see ObbFiles
+	DeliveryFileObb
+)
+
+// File of a delivered app, the base APK, a split APK or an expansion file
+type DeliveryFile struct {
+	// BaseApkName or the split name, e.g., "config.arm64_v8a".
+	// For expansion files the name without extension, e.g., "main.42.com.example"
+	Name   string
+	Type   DeliveryFileType
+	Url    string
+	Size   int64
+	Sha1   []byte
+	Sha256 []byte
+}
+
+/**
+Filename as the file is named on devices, e.g., "base.apk", "split_config.arm64_v8a.apk"
+or "main.42.com.example.obb"
+*/
+func (file *DeliveryFile) FileName() string {
+	if file.Type == DeliveryFileObb {
+		return file.Name + ".obb"
+	}
+	if file.Name == BaseApkName {
+		return BaseApkName + ".apk"
+	}
+	return fmt.Sprintf("split_%s.apk", file.Name)
+}
+
+/**
+Download the file, verifying the sha256 checksum or sha1 if the server did not return sha256
+*/
+func (file *DeliveryFile) Download() (io.ReadCloser, error) {
+	if len(file.Sha256) > 0 {
+		return DownloadVerifySha256(file.Url, file.Size, file.Sha256)
+	}
+	return DownloadVerifySha1(file.Url, file.Size, file.Sha1)
+}
+
+/**
+Get the base APK and the split APKs of the delivery data, base APK first
+*/
+func DeliveryFiles(deliveryData *pb.AndroidAppDeliveryData) ([]DeliveryFile, error) {
+	if deliveryData.GetDownloadUrl() == "" {
+		return nil, fmt.Errorf("deliver data does not contain download Url")
+	}
+
+	base, err := newDeliveryFile(BaseApkName, deliveryData.GetDownloadUrl(), deliveryData.GetDownloadSize(),
+		deliveryData.GetSha1(), deliveryData.GetSha256())
+	if err != nil {
+		return nil, err
+	}
+
+	files := []DeliveryFile{*base}
+	for _, split := range deliveryData.GetSplit() {
+		file, err := newDeliveryFile(split.GetName(), split.GetDownloadUrl(), split.GetSize(),
+			split.GetSha1(), split.GetSha256())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
+	}
+	return files, nil
+}
+
+// Types of AppFileMetadata.fileType
+const (
+	obbTypeMain  = 0
+	obbTypePatch = 1
+)
+
+/**
+Get the APK expansion files (OBBs) of the delivery data, the main file first
+
+The files are named as Android expects them in "Android/obb/<packageName>/"
+*/
+func ObbFiles(packageName string, deliveryData *pb.AndroidAppDeliveryData) ([]DeliveryFile, error) {
+	var files []DeliveryFile
+	for _, additionalFile := range deliveryData.GetAdditionalFile() {
+		var obbType string
+		switch additionalFile.GetFileType() {
+		case obbTypeMain:
+			obbType = "main"
+		case obbTypePatch:
+			obbType = "patch"
+		default:
+			return nil, fmt.Errorf("unknown expansion file type %d", additionalFile.GetFileType())
+		}
+
+		name := fmt.Sprintf("%s.%d.%s", obbType, additionalFile.GetVersionCode(), packageName)
+		file, err := newDeliveryFile(name, additionalFile.GetDownloadUrl(), additionalFile.GetSize(),
+			additionalFile.GetSha1(), "")
+		if err != nil {
+			return nil, err
+		}
+		file.Type = DeliveryFileObb
+		files = append(files, *file)
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// Checksums are base64 encoded with URL and Filename Safe Alphabet with padding removed
+func newDeliveryFile(name string, url string, size int64, sha1B64 string, sha256B64 string) (*DeliveryFile, error) {
+	sha1Checksum, err := base64.RawURLEncoding.DecodeString(sha1B64)
+	if err != nil {
+		return nil, err
+	}
+
+	sha256Checksum, err := base64.RawURLEncoding.DecodeString(sha256B64)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sha1Checksum) == 0 && len(sha256Checksum) == 0 {
+		return nil, fmt.Errorf("%s does not have checksum", name)
+	}
+
+	return &DeliveryFile{
+		Name:   name,
+		Url:    url,
+		Size:   size,
+		Sha1:   sha1Checksum,
+		Sha256: sha256Checksum,
+	}, nil
+}
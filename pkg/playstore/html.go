@@ -0,0 +1,28 @@
+package playstore
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	htmlLineBreakRegex = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>`)
+	htmlListItemRegex  = regexp.MustCompile(`(?i)<li[^>]*>`)
+	htmlTagRegex       = regexp.MustCompile(`<[^>]*>`)
+	blankLinesRegex    = regexp.MustCompile(`\n{3,}`)
+)
+
+/**
+Convert the HTML the server uses in descriptions and changelogs to plain text
+
+Line breaks and list items are kept, other tags are removed
+*/
+func HtmlToText(htmlText string) string {
+	text := htmlLineBreakRegex.ReplaceAllString(htmlText, "\n")
+	text = htmlListItemRegex.ReplaceAllString(text, "\n• ")
+	text = htmlTagRegex.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	text = blankLinesRegex.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
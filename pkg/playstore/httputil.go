@@ -1,12 +1,17 @@
 package playstore
 
 import (
+	"github.com/gojektech/heimdall/v6"
 	"github.com/gojektech/heimdall/v6/hystrix"
 	"net/http"
 	"time"
 )
 
-func createHTTPClient() (*hystrix.Client, error) {
+// Plain client with the transport if it is set, e.g., in tests
+func createHTTPClient(transport http.RoundTripper) (heimdall.Doer, error) {
+	if transport != nil {
+		return &http.Client{Transport: transport, Timeout: 5 * time.Second}, nil
+	}
 	return hystrix.NewClient(
 		hystrix.WithHTTPTimeout(5*time.Second),
 		hystrix.WithMaxConcurrentRequests(10),
@@ -17,7 +22,7 @@ func createHTTPClient() (*hystrix.Client, error) {
 
 // Sometimes the server returns 404 Not Found when using fresh credentials
 // This may be a caching problem, so try retrying few times
-func httpDoRetryOnNotFound(httpClient *hystrix.Client, req *http.Request) (res *http.Response, err error) {
+func httpDoRetryOnNotFound(httpClient heimdall.Doer, req *http.Request) (res *http.Response, err error) {
 	const retryCount = 4
 
 	for i := 0; i < retryCount; i++ {
@@ -0,0 +1,201 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"sort"
+	"time"
+)
+
+const (
+	ReplicateLibraryUrl = FDFEUrl + "replicateLibrary"
+	ModifyLibraryUrl    = FDFEUrl + "modifyLibrary"
+)
+
+const (
+	// Corpus (backend) of the apps
+	AppsCorpus = 3
+	// Library containing the apps the account "owns"
+	AppsLibraryId = "3"
+)
+
+// Guards against a server that keeps reporting more library updates
+const maxLibraryReplications = 100
+
+// How long the replicated library is kept in the response cache, the library is replicated fully after that
+const libraryCacheTTL = 30 * 24 * time.Hour
+
+/**
+Replicate the library changes since `state`
+
+The server returns the changes in batches, the returned updates contain server token
+that is passed in the next `state` to continue from where the previous replication ended
+*/
+func (client *Client) ReplicateLibrary(state *pb.ClientLibraryState) ([]*pb.LibraryUpdate, error) {
+	res, err := client.sendProto(ReplicateLibraryUrl, &pb.LibraryReplicationRequest{
+		LibraryState: []*pb.ClientLibraryState{state},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replicationRes := res.GetPayload().GetLibraryReplicationResponse()
+	if replicationRes == nil {
+		return nil, fmt.Errorf("response does not contain library replication response")
+	}
+	return replicationRes.Update, nil
+}
+
+/**
+Get the apps in the account library, i.e., the apps the account has "purchased"
+
+The mutations are keyed by package name. The library and the server token of the replication are saved
+in the response cache, so only the changes since the previous call are replicated.
+Without a cache, the whole library is replicated
+*/
+func (client *Client) GetLibrary() (map[string]*pb.LibraryMutation, error) {
+	apps, serverToken := client.loadLibrary()
+
+	state := &pb.ClientLibraryState{
+		Corpus:      proto.Int32(AppsCorpus),
+		LibraryId:   proto.String(AppsLibraryId),
+		ServerToken: serverToken,
+	}
+
+	for i := 0; i < maxLibraryReplications; i++ {
+		updates, err := client.ReplicateLibrary(state)
+		if err != nil {
+			return nil, err
+		}
+
+		hasMore := false
+		for _, update := range updates {
+			if update.GetLibraryId() != AppsLibraryId {
+				continue
+			}
+
+			for _, mutation := range update.Mutation {
+				packageName := mutation.GetDocid().GetBackendDocid()
+				if mutation.GetDeleted() {
+					delete(apps, packageName)
+				} else {
+					apps[packageName] = mutation
+				}
+			}
+
+			if update.ServerToken != nil {
+				state.ServerToken = update.ServerToken
+			}
+			hasMore = hasMore || update.GetHasMore()
+		}
+
+		if !hasMore {
+			client.saveLibrary(apps, state.ServerToken)
+			return apps, nil
+		}
+	}
+	return nil, fmt.Errorf("library replication did not finish after %d requests", maxLibraryReplications)
+}
+
+// Library saved by `saveLibrary`, empty library and nil token if there is none
+func (client *Client) loadLibrary() (map[string]*pb.LibraryMutation, []byte) {
+	apps := map[string]*pb.LibraryMutation{}
+	if client.cache == nil {
+		return apps, nil
+	}
+
+	key := client.cacheKey(ReplicateLibraryUrl)
+	entry, ok := client.cache.Get(key)
+	if !ok {
+		return apps, nil
+	}
+	if entry.isExpired(time.Now()) {
+		client.cache.Delete(key)
+		return apps, nil
+	}
+
+	updates := entry.Response.GetPayload().GetLibraryReplicationResponse().GetUpdate()
+	if len(updates) != 1 {
+		return apps, nil
+	}
+	for _, mutation := range updates[0].Mutation {
+		apps[mutation.GetDocid().GetBackendDocid()] = mutation
+	}
+	return apps, updates[0].ServerToken
+}
+
+// Saved as a replication response with one update containing the apps, so the response cache can store it
+func (client *Client) saveLibrary(apps map[string]*pb.LibraryMutation, serverToken []byte) {
+	if client.cache == nil || serverToken == nil {
+		return
+	}
+
+	update := &pb.LibraryUpdate{
+		Corpus:      proto.Int32(AppsCorpus),
+		LibraryId:   proto.String(AppsLibraryId),
+		ServerToken: serverToken,
+	}
+	for _, mutation := range apps {
+		update.Mutation = append(update.Mutation, mutation)
+	}
+
+	now := time.Now()
+	client.cache.Set(client.cacheKey(ReplicateLibraryUrl), &CacheEntry{
+		Response: &pb.ResponseWrapper{Payload: &pb.Payload{LibraryReplicationResponse: &pb.LibraryReplicationResponse{
+			Update: []*pb.LibraryUpdate{update},
+		}}},
+		SoftExpires: now.Add(libraryCacheTTL),
+		Expires:     now.Add(libraryCacheTTL),
+	})
+}
+
+/**
+Get the package names of the apps in the account library, sorted
+*/
+func (client *Client) GetLibraryPackageNames() ([]string, error) {
+	apps, err := client.GetLibrary()
+	if err != nil {
+		return nil, err
+	}
+
+	packageNames := make([]string, 0, len(apps))
+	for packageName := range apps {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+	return packageNames, nil
+}
+
+/**
+Get the signing certificate hashes of the apps in the account library, see `TrustStore.VerifyLibraryHash`.
+Apps without a hash are left out
+*/
+func (client *Client) GetLibraryCertificateHashes() (map[string]string, error) {
+	apps, err := client.GetLibrary()
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]string{}
+	for packageName, mutation := range apps {
+		if hash := mutation.GetAppDetails().GetCertificateHash(); hash != "" {
+			hashes[packageName] = hash
+		}
+	}
+	return hashes, nil
+}
+
+/**
+Add and remove apps from the account library
+
+Only removes the app from the library, does not refund purchases
+*/
+func (client *Client) ModifyLibrary(addPackageNames []string, removePackageNames []string) error {
+	_, err := client.sendProto(ModifyLibraryUrl, &pb.ModifyLibraryRequest{
+		LibraryId:         proto.String(AppsLibraryId),
+		AddPackageName:    addPackageNames,
+		RemovePackageName: removePackageNames,
+	})
+	return err
+}
@@ -0,0 +1,65 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func libraryMutation(packageName string, deleted bool) *pb.LibraryMutation {
+	return &pb.LibraryMutation{Docid: &pb.Docid{BackendDocid: proto.String(packageName)}, Deleted: proto.Bool(deleted)}
+}
+
+func TestGetLibraryIncremental(t *testing.T) {
+	// Changes since the server token, the full library without token
+	changes := map[string][]*pb.LibraryMutation{
+		"":  {libraryMutation("com.example.a", false), libraryMutation("com.example.b", false)},
+		"1": {libraryMutation("com.example.b", true), libraryMutation("com.example.c", false)},
+		"2": nil,
+	}
+	var tokens []string
+
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"replicateLibrary": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			body, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			replicationReq := &pb.LibraryReplicationRequest{}
+			if err = proto.Unmarshal(body, replicationReq); err != nil {
+				return nil, err
+			}
+
+			token := string(replicationReq.GetLibraryState()[0].GetServerToken())
+			tokens = append(tokens, token)
+			return &pb.ResponseWrapper{Payload: &pb.Payload{LibraryReplicationResponse: &pb.LibraryReplicationResponse{
+				Update: []*pb.LibraryUpdate{{
+					LibraryId:   proto.String(AppsLibraryId),
+					ServerToken: []byte(fmt.Sprint(len(tokens))),
+					Mutation:    changes[token],
+				}},
+			}}}, nil
+		},
+	}}
+	client := createFakePlayStoreClient(t, store, &Config{Cache: NewMemoryCache(DefaultMemoryCacheSize)})
+
+	expected := [][]string{{"com.example.a", "com.example.b"}, {"com.example.a", "com.example.c"},
+		{"com.example.a", "com.example.c"}}
+	for _, packageNames := range expected {
+		res, err := client.GetLibraryPackageNames()
+		if err != nil {
+			t.Fatalf("Could not get library: %v", err)
+		}
+		if !reflect.DeepEqual(res, packageNames) {
+			t.Fatalf("Library is incorrect: %v, should be: %v", res, packageNames)
+		}
+	}
+
+	if !reflect.DeepEqual(tokens, []string{"", "1", "2"}) {
+		t.Fatalf("Server token of the previous replication was not sent: %q", tokens)
+	}
+}
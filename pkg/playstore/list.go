@@ -0,0 +1,96 @@
+package playstore
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"net/url"
+)
+
+// Guards against lists whose next page links loop
+const maxListPages = 50
+
+// Document type of an app, other types are containers or other content
+const appDocType = 1
+
+/**
+Fetch a document list and follow its next page links until the list is exhausted
+
+Apps are either directly in the payload or nested inside container documents,
+containers are flattened. Each app is returned once, in the order the server listed them
+*/
+func (client *Client) getAppList(listUrl string) ([]*pb.DocV2, error) {
+	var apps []*pb.DocV2
+	seen := map[string]bool{}
+
+	for page := 0; listUrl != "" && page < maxListPages; page++ {
+		resWrap, err := client.sendCacheable(listUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		docs, nextPageUrl := payloadDocs(resWrap.Payload)
+		for _, doc := range flattenAppDocs(docs) {
+			if seen[doc.GetDocid()] {
+				continue
+			}
+			seen[doc.GetDocid()] = true
+			apps = append(apps, doc)
+		}
+
+		listUrl, err = resolveFDFEUrl(nextPageUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return apps, nil
+}
+
+// Get the documents of a list or search payload and the (relative) URL of the next page
+func payloadDocs(payload *pb.Payload) (docs []*pb.DocV2, nextPageUrl string) {
+	if payload.GetSearchResponse() != nil {
+		docs = payload.GetSearchResponse().GetDoc()
+		nextPageUrl = payload.GetSearchResponse().GetNextPageUrl()
+	} else {
+		docs = payload.GetListResponse().GetDoc()
+	}
+
+	// The next page link of nested lists is in the container metadata
+	for _, doc := range docs {
+		if nextPageUrl != "" {
+			break
+		}
+		nextPageUrl = doc.GetContainerMetadata().GetNextPageUrl()
+	}
+	return
+}
+
+func flattenAppDocs(docs []*pb.DocV2) []*pb.DocV2 {
+	var apps []*pb.DocV2
+	for _, doc := range docs {
+		if len(doc.GetChild()) > 0 {
+			apps = append(apps, flattenAppDocs(doc.GetChild())...)
+			continue
+		}
+		if doc.GetDocType() == appDocType {
+			apps = append(apps, doc)
+		}
+	}
+	return apps
+}
+
+// The server returns links relative to the FDFE endpoint, e.g., "search?c=3&q=...&o=20"
+func resolveFDFEUrl(link string) (string, error) {
+	if link == "" {
+		return "", nil
+	}
+
+	base, err := url.Parse(FDFEUrl)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := url.Parse(link)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(ref).String(), nil
+}
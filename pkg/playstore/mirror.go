@@ -0,0 +1,346 @@
+package playstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const MirrorIndexName = "index.json"
+
+type MirrorFile struct {
+	// APK filename in the version directory, e.g., "base.apk"
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+type MirrorVersion struct {
+	VersionCode   int    `json:"versionCode"`
+	VersionString string `json:"versionString"`
+	// Relative to the mirror directory
+	Dir   string       `json:"dir"`
+	Files []MirrorFile `json:"files"`
+	Added time.Time    `json:"added"`
+}
+
+type MirrorApp struct {
+	PackageName string `json:"packageName"`
+	// Details of the latest version
+	Info *AppInfo `json:"info"`
+	// Latest version first
+	Versions []MirrorVersion `json:"versions"`
+}
+
+// Contents of the mirror, saved as index.json in the mirror directory
+type MirrorIndex struct {
+	Updated time.Time             `json:"updated"`
+	Apps    map[string]*MirrorApp `json:"apps"`
+}
+
+type MirrorUpdateStatus string
+
+const (
+	MirrorUnchanged MirrorUpdateStatus = "unchanged"
+	MirrorUpdated   MirrorUpdateStatus = "updated"
+	MirrorFailed    MirrorUpdateStatus = "failed"
+)
+
+type MirrorUpdate struct {
+	PackageName string             `json:"packageName"`
+	Status      MirrorUpdateStatus `json:"status"`
+	// Zero if the app was not mirrored before
+	OldVersionCode int    `json:"oldVersionCode,omitempty"`
+	NewVersionCode int    `json:"newVersionCode,omitempty"`
+	Error          string `json:"error,omitempty"`
+	// Version codes removed because there were more than kept versions
+	Pruned []int `json:"pruned,omitempty"`
+}
+
+/**
+Keeps a local directory of APKs up to date with the playstore
+
+Versions are saved to <dir>/<package>/<version code>/ with their splits and listed in <dir>/index.json.
+Updating is idempotent, only new versions are downloaded and interrupted updates are continued on the next run
+*/
+type Mirror struct {
+	client *Client
+	dir    string
+	// Number of previous versions kept in addition to the latest
+	keepVersions int
+}
+
+func NewMirror(client *Client, dir string, keepVersions int) *Mirror {
+	return &Mirror{
+		client:       client,
+		dir:          dir,
+		keepVersions: keepVersions,
+	}
+}
+
+func (mirror *Mirror) Dir() string {
+	return mirror.dir
+}
+
+/**
+Load the index of the mirror in `dir`, empty index if the mirror does not have one yet
+*/
+func LoadMirrorIndex(dir string) (*MirrorIndex, error) {
+	index := &MirrorIndex{Apps: map[string]*MirrorApp{}}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, MirrorIndexName))
+	if os.IsNotExist(err) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("could not parse mirror index: %v", err)
+	}
+	if index.Apps == nil {
+		index.Apps = map[string]*MirrorApp{}
+	}
+	return index, nil
+}
+
+func (mirror *Mirror) LoadIndex() (*MirrorIndex, error) {
+	return LoadMirrorIndex(mirror.dir)
+}
+
+// Write to a temporary file first, so an interrupted write does not corrupt the index
+func (mirror *Mirror) saveIndex(index *MirrorIndex) error {
+	index.Updated = time.Now().UTC()
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	indexPath := filepath.Join(mirror.dir, MirrorIndexName)
+	if err = ioutil.WriteFile(indexPath+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(indexPath+".tmp", indexPath)
+}
+
+/**
+Check the latest versions of the apps and download the changed ones
+
+An app failing to update does not stop updating the others, the errors are in the returned updates.
+Returns error only if the mirror directory or index cannot be used
+*/
+func (mirror *Mirror) Update(packageNames []string) ([]MirrorUpdate, error) {
+	if err := os.MkdirAll(mirror.dir, 0755); err != nil {
+		return nil, err
+	}
+
+	index, err := mirror.LoadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := mirror.client.GetBulkDetails(packageNames)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]MirrorUpdate, len(packageNames))
+	for i, packageName := range packageNames {
+		update := &updates[i]
+		update.PackageName = packageName
+
+		app, ok := index.Apps[packageName]
+		if !ok {
+			app = &MirrorApp{PackageName: packageName}
+		}
+		if len(app.Versions) > 0 {
+			update.OldVersionCode = app.Versions[0].VersionCode
+		}
+
+		pruned, err := mirror.updateApp(app, docs[i], update)
+		if err != nil {
+			log.Errorf("Could not mirror %s: %v", packageName, err)
+			update.Status = MirrorFailed
+			update.Error = err.Error()
+			continue
+		}
+
+		if update.Status == MirrorUpdated {
+			index.Apps[packageName] = app
+			if err := mirror.saveIndex(index); err != nil {
+				return nil, err
+			}
+			// Removed after the index no longer lists them, a version left behind only takes space
+			mirror.removeVersions(pruned)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(mirror.dir, MirrorIndexName)); os.IsNotExist(err) {
+		if err = mirror.saveIndex(index); err != nil {
+			return nil, err
+		}
+	}
+	return updates, nil
+}
+
+// Returns the versions pruned from the app, their directories are not removed yet
+func (mirror *Mirror) updateApp(app *MirrorApp, doc *pb.DocV2, update *MirrorUpdate) ([]MirrorVersion, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("details not found, the app may be removed or not available for the device")
+	}
+
+	info := NewAppInfo(doc)
+	if info.VersionCode == 0 {
+		return nil, fmt.Errorf("details did not contain version code")
+	}
+	update.NewVersionCode = info.VersionCode
+
+	for _, version := range app.Versions {
+		if version.VersionCode == info.VersionCode && mirror.hasFiles(version) {
+			update.Status = MirrorUnchanged
+			return nil, nil
+		}
+	}
+
+	log.Infof("Mirror %s version %d", app.PackageName, info.VersionCode)
+
+	version, err := mirror.downloadVersion(info)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replaces the possibly incomplete entry of the same version
+	versions := []MirrorVersion{*version}
+	for _, oldVersion := range app.Versions {
+		if oldVersion.VersionCode != version.VersionCode {
+			versions = append(versions, oldVersion)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].VersionCode > versions[j].VersionCode
+	})
+
+	var pruned []MirrorVersion
+	if keep := 1 + mirror.keepVersions; len(versions) > keep {
+		pruned = append(pruned, versions[keep:]...)
+		for _, oldVersion := range pruned {
+			update.Pruned = append(update.Pruned, oldVersion.VersionCode)
+		}
+		versions = versions[:keep]
+	}
+
+	app.Info = info
+	app.Versions = versions
+	update.Status = MirrorUpdated
+	return pruned, nil
+}
+
+func (mirror *Mirror) removeVersions(versions []MirrorVersion) {
+	for _, version := range versions {
+		if err := os.RemoveAll(filepath.Join(mirror.dir, version.Dir)); err != nil {
+			log.Warnf("Could not remove pruned version %s: %v", version.Dir, err)
+		}
+	}
+}
+
+func (mirror *Mirror) hasFiles(version MirrorVersion) bool {
+	if len(version.Files) == 0 {
+		return false
+	}
+
+	for _, file := range version.Files {
+		stat, err := os.Stat(filepath.Join(mirror.dir, version.Dir, file.Name))
+		if err != nil || stat.Size() != file.Size {
+			return false
+		}
+	}
+	return true
+}
+
+// Download to a temporary directory first, so interrupted downloads are not mistaken for complete versions
+func (mirror *Mirror) downloadVersion(info *AppInfo) (*MirrorVersion, error) {
+	deliveryData, err := mirror.client.GetAppDeliveryData(info.PackageName, info.VersionCode)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := DeliveryFiles(deliveryData)
+	if err != nil {
+		return nil, err
+	}
+
+	relDir := filepath.Join(info.PackageName, strconv.Itoa(info.VersionCode))
+	versionDir := filepath.Join(mirror.dir, relDir)
+	tmpDir := versionDir + ".tmp"
+
+	if err = os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, err
+	}
+
+	version := &MirrorVersion{
+		VersionCode:   info.VersionCode,
+		VersionString: info.VersionString,
+		Dir:           filepath.ToSlash(relDir),
+		Added:         time.Now().UTC(),
+	}
+
+	for _, file := range files {
+		mirrorFile, err := downloadMirrorFile(file, tmpDir)
+		if err != nil {
+			_ = os.RemoveAll(tmpDir)
+			return nil, err
+		}
+		version.Files = append(version.Files, *mirrorFile)
+	}
+
+	if err = os.RemoveAll(versionDir); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(tmpDir, versionDir); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+func downloadMirrorFile(file DeliveryFile, dir string) (*MirrorFile, error) {
+	reader, err := file.Download()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	f, err := os.Create(filepath.Join(dir, file.FileName()))
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), reader)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not download %s: %v", file.FileName(), err)
+	}
+
+	return &MirrorFile{
+		Name:   file.FileName(),
+		Size:   size,
+		Sha256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package playstore
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func apkContent(versionCode int) []byte {
+	return []byte(fmt.Sprintf("apk of version %d", versionCode))
+}
+
+// Fake playstore serving `*latest` as the latest version of TestPackageName, APKs are downloaded from `apkServer`
+func createMirrorTestStore(latest *int, apkServer *httptest.Server) *fakePlayStore {
+	return &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"bulkDetails": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			doc := detailsResponse(TestPackageName, int32(*latest)).GetPayload().GetDetailsResponse().GetDocV2()
+			return &pb.ResponseWrapper{Payload: &pb.Payload{BulkDetailsResponse: &pb.BulkDetailsResponse{
+				Entry: []*pb.BulkDetailsEntry{{Doc: doc}},
+			}}}, nil
+		},
+		"details": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			return detailsResponse(TestPackageName, int32(*latest)), nil
+		},
+		"delivery": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			versionCode, err := strconv.Atoi(req.URL.Query().Get("vc"))
+			if err != nil {
+				return nil, err
+			}
+			content := apkContent(versionCode)
+			checksum := sha256.Sum256(content)
+
+			return &pb.ResponseWrapper{Payload: &pb.Payload{DeliveryResponse: &pb.DeliveryResponse{
+				AppDeliveryData: &pb.AndroidAppDeliveryData{
+					DownloadUrl:  proto.String(fmt.Sprintf("%s/%d.apk", apkServer.URL, versionCode)),
+					DownloadSize: proto.Int64(int64(len(content))),
+					Sha256:       proto.String(base64.RawURLEncoding.EncodeToString(checksum[:])),
+				},
+			}}}, nil
+		},
+	}}
+}
+
+func TestMirrorUpdate(t *testing.T) {
+	apkServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versionCode, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), ".apk"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(apkContent(versionCode))
+	}))
+	defer apkServer.Close()
+
+	dir, err := ioutil.TempDir("", "gplay-mirror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	latest := 1
+	store := createMirrorTestStore(&latest, apkServer)
+	mirror := NewMirror(createFakePlayStoreClient(t, store, nil), dir, 1)
+
+	update := func() MirrorUpdate {
+		updates, err := mirror.Update([]string{TestPackageName})
+		if err != nil {
+			t.Fatalf("Could not update mirror: %v", err)
+		}
+		if len(updates) != 1 {
+			t.Fatalf("Number of updates is incorrect: %+v", updates)
+		}
+		return updates[0]
+	}
+
+	if res := update(); res.Status != MirrorUpdated || res.OldVersionCode != 0 || res.NewVersionCode != 1 {
+		t.Fatalf("First update is incorrect: %+v", res)
+	}
+
+	// Re-run without a new version does not download
+	if res := update(); res.Status != MirrorUnchanged || res.NewVersionCode != 1 {
+		t.Fatalf("Update without new version is incorrect: %+v", res)
+	}
+	if deliveries := store.requested("delivery"); deliveries != 1 {
+		t.Fatalf("Unchanged version was downloaded again, deliveries: %d", deliveries)
+	}
+
+	for latest = 2; latest <= 3; latest++ {
+		if res := update(); res.Status != MirrorUpdated || res.OldVersionCode != latest-1 {
+			t.Fatalf("Update to version %d is incorrect: %+v", latest, res)
+		}
+	}
+	latest = 4
+	res := update()
+	if res.Status != MirrorUpdated || len(res.Pruned) != 1 || res.Pruned[0] != 2 {
+		t.Fatalf("Versions beyond the kept versions were not pruned: %+v", res)
+	}
+
+	index, err := LoadMirrorIndex(dir)
+	if err != nil {
+		t.Fatalf("Could not load mirror index: %v", err)
+	}
+	app := index.Apps[TestPackageName]
+	if app == nil || len(app.Versions) != 2 || app.Versions[0].VersionCode != 4 || app.Versions[1].VersionCode != 3 {
+		t.Fatalf("Mirror index was not updated: %+v", app)
+	}
+
+	for _, version := range app.Versions {
+		data, err := ioutil.ReadFile(filepath.Join(dir, version.Dir, BaseApkName+".apk"))
+		if err != nil || string(data) != string(apkContent(version.VersionCode)) {
+			t.Fatalf("APK of version %d is incorrect: %s, %v", version.VersionCode, data, err)
+		}
+	}
+	for _, versionCode := range []int{1, 2} {
+		if _, err := os.Stat(filepath.Join(dir, TestPackageName, strconv.Itoa(versionCode))); !os.IsNotExist(err) {
+			t.Fatalf("Pruned version %d was not removed: %v", versionCode, err)
+		}
+	}
+
+	// The index still lists the version, if the index cannot be saved
+	if err = os.Mkdir(filepath.Join(dir, MirrorIndexName+".tmp"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	latest = 5
+	if _, err = mirror.Update([]string{TestPackageName}); err == nil {
+		t.Fatalf("Update should fail, if the index cannot be saved")
+	}
+	if _, err = os.Stat(filepath.Join(dir, TestPackageName, "3")); err != nil {
+		t.Fatalf("Version listed in the saved index was removed: %v", err)
+	}
+}
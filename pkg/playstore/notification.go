@@ -0,0 +1,72 @@
+package playstore
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+/**
+Called with the notifications the server attaches to responses, e.g., library updates,
+delivery data of apps and removals of purchased apps
+
+Handlers are called in the goroutine making the request, so they should not block
+*/
+type NotificationHandler func(notification *pb.Notification)
+
+/**
+Register a handler for the server notifications. Notifications of cached responses are not handled again
+*/
+func (client *Client) OnNotification(handler NotificationHandler) {
+	client.notificationMutex.Lock()
+	defer client.notificationMutex.Unlock()
+
+	client.notificationHandlers = append(client.notificationHandlers, handler)
+}
+
+/**
+Receive the server notifications from a channel with `size` buffer
+
+Requests do not wait for the channel to be read, notifications are dropped when the buffer is full
+*/
+func (client *Client) NotificationChannel(size int) <-chan *pb.Notification {
+	notifications := make(chan *pb.Notification, size)
+
+	client.OnNotification(func(notification *pb.Notification) {
+		select {
+		case notifications <- notification:
+		default:
+			log.Warnf("Notification channel is full, dropped notification %s", notification.GetNotificationId())
+		}
+	})
+	return notifications
+}
+
+func (client *Client) handleNotifications(responseWrapper *pb.ResponseWrapper) {
+	if len(responseWrapper.GetNotification()) == 0 {
+		return
+	}
+
+	client.notificationMutex.Lock()
+	handlers := client.notificationHandlers
+	client.notificationMutex.Unlock()
+
+	for _, notification := range responseWrapper.GetNotification() {
+		log.Debugf("Notification %s for %s", notification.GetNotificationId(), NotificationPackageName(notification))
+
+		for _, handler := range handlers {
+			handler(notification)
+		}
+	}
+}
+
+// Package name of the app the notification is about, empty if the notification is not about an app
+func NotificationPackageName(notification *pb.Notification) string {
+	return notification.GetDocid().GetBackendDocid()
+}
+
+/**
+Whether the playstore removed the app from the account, because it was found to be malicious
+*/
+func IsMaliciousRemoval(notification *pb.Notification) bool {
+	return notification.GetPurchaseRemovalData().GetMalicious()
+}
@@ -0,0 +1,39 @@
+package playstore
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"testing"
+)
+
+func TestNotifications(t *testing.T) {
+	client := &Client{}
+
+	var malicious []string
+	client.OnNotification(func(notification *pb.Notification) {
+		if IsMaliciousRemoval(notification) {
+			malicious = append(malicious, NotificationPackageName(notification))
+		}
+	})
+	notifications := client.NotificationChannel(1)
+
+	client.handleNotifications(&pb.ResponseWrapper{Notification: []*pb.Notification{
+		{
+			Docid:               &pb.Docid{BackendDocid: proto.String(TestPackageName)},
+			PurchaseRemovalData: &pb.PurchaseRemovalData{Malicious: proto.Bool(true)},
+		},
+		{LibraryUpdate: &pb.LibraryUpdate{}},
+	}})
+
+	if len(malicious) != 1 || malicious[0] != TestPackageName {
+		t.Fatalf("Malicious removal was not handled: %v", malicious)
+	}
+
+	// The second notification is dropped, because the channel is full
+	if notification := <-notifications; !IsMaliciousRemoval(notification) {
+		t.Fatalf("Channel notification is incorrect: %v", notification)
+	}
+	if len(notifications) != 0 {
+		t.Fatal("Notification should have been dropped")
+	}
+}
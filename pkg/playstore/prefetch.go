@@ -0,0 +1,47 @@
+package playstore
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+/**
+Store the responses the server prefetched for the URLs the client likely requests next,
+e.g., details of search results. Nothing is stored if the client does not have cache.
+
+Prefetched responses without TTL use the client cache TTL
+*/
+func (client *Client) storePrefetched(responseWrapper *pb.ResponseWrapper) {
+	if client.cache == nil {
+		return
+	}
+
+	now := time.Now()
+	for _, preFetch := range responseWrapper.GetPreFetch() {
+		if preFetch.GetResponse() == nil {
+			continue
+		}
+
+		prefetchUrl, err := resolveFDFEUrl(preFetch.GetUrl())
+		if err != nil || prefetchUrl == "" {
+			log.Debugf("Ignore prefetched response with invalid url %q", preFetch.GetUrl())
+			continue
+		}
+
+		ttl := preFetch.GetTtl()
+		if ttl == 0 {
+			ttl = int64(client.cacheTTL / time.Millisecond)
+		}
+		if ttl == 0 {
+			continue
+		}
+
+		entry := newCacheEntry(preFetch.GetResponse(), preFetch.GetEtag(), now, ttl, preFetch.GetSoftTtl())
+		entry.Prefetched = true
+
+		log.Debugf("Cache prefetched response of %s", prefetchUrl)
+		client.cache.Set(client.cacheKey(prefetchUrl), entry)
+		client.updateCacheStats(func(stats *CacheStats) { stats.Prefetched++ })
+	}
+}
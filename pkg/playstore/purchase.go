@@ -0,0 +1,132 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	log "github.com/sirupsen/logrus"
+	"net/url"
+	"strconv"
+)
+
+// Offer type used when the app details do not contain offers
+const defaultOfferType = 1
+
+/**
+Returned when the app is not free and the account does not own it
+
+Apps cannot be bought using this client, buy the app with the account first
+*/
+type PaymentRequiredError struct {
+	PackageName string
+	Price       *pb.Money
+}
+
+func (err *PaymentRequiredError) Error() string {
+	return fmt.Sprintf("%s is a paid app (%s) that the account does not own, buy it first",
+		err.PackageName, err.Price.GetFormattedAmount())
+}
+
+/**
+Returned when the server requires the user to complete a challenge, e.g., to enter the account password
+or a billing address, before the purchase continues
+*/
+type ChallengeRequiredError struct {
+	PackageName string
+	Challenge   *pb.Challenge
+}
+
+func (err *ChallengeRequiredError) Error() string {
+	if authChallenge := err.Challenge.GetAuthenticationChallenge(); authChallenge != nil {
+		return fmt.Sprintf("purchase of %s requires authentication: %s",
+			err.PackageName, authChallenge.GetGaiaHeaderText())
+	}
+	if err.Challenge.GetAddressChallenge() != nil {
+		return fmt.Sprintf("purchase of %s requires billing address", err.PackageName)
+	}
+	return fmt.Sprintf("purchase of %s requires completing a challenge", err.PackageName)
+}
+
+/**
+Select the offer the app is acquired with
+
+Prefer free offers, e.g., free apps and free trials, otherwise returns the first offer.
+Returns nil if the details do not contain offers
+*/
+func selectOffer(doc *pb.DocV2) *pb.Offer {
+	for _, offer := range doc.GetOffer() {
+		if offer.GetMicros() == 0 {
+			return offer
+		}
+	}
+
+	if len(doc.GetOffer()) > 0 {
+		return doc.GetOffer()[0]
+	}
+	return nil
+}
+
+func offerType(offer *pb.Offer) int {
+	if offer == nil || offer.OfferType == nil {
+		return defaultOfferType
+	}
+	return int(offer.GetOfferType())
+}
+
+func offerPrice(offer *pb.Offer) *pb.Money {
+	return &pb.Money{
+		Micros:          proto.Int64(offer.GetMicros()),
+		CurrencyCode:    proto.String(offer.GetCurrencyCode()),
+		FormattedAmount: proto.String(offer.GetFormattedAmount()),
+	}
+}
+
+/**
+"Purchase" the app using the offer of type `offerType`
+
+Free apps are added to the library. If the app requires payment or the user to complete a challenge,
+returns PaymentRequiredError or ChallengeRequiredError
+*/
+func (client *Client) PurchaseOffer(packageName string, versionCode int, offerType int) (*pb.BuyResponse, error) {
+	params := &url.Values{}
+	params.Set("ot", strconv.Itoa(offerType))
+	params.Set("doc", packageName)
+	params.Set("vc", strconv.Itoa(versionCode))
+
+	res, err := client.send(PurchaseUrl, params)
+	if err != nil {
+		log.Errorf("Purchase error: %v, %v", res, err)
+		return nil, err
+	}
+
+	buyRes := res.GetPayload().GetBuyResponse()
+	if buyRes == nil {
+		return nil, fmt.Errorf("response does not contain buy response")
+	}
+
+	if buyRes.Challenge != nil {
+		return buyRes, &ChallengeRequiredError{PackageName: packageName, Challenge: buyRes.Challenge}
+	}
+
+	if buyRes.PurchaseStatusResponse == nil && buyRes.Checkoutinfo != nil {
+		return buyRes, &PaymentRequiredError{
+			PackageName: packageName,
+			Price:       buyRes.Checkoutinfo.GetItem().GetAmount(),
+		}
+	}
+
+	// Status is sometimes only available from a separate URL
+	if buyRes.PurchaseStatusResponse == nil && buyRes.GetPurchaseStatusUrl() != "" {
+		statusUrl, err := resolveFDFEUrl(buyRes.GetPurchaseStatusUrl())
+		if err != nil {
+			return nil, err
+		}
+
+		statusRes, err := client.send(statusUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+		buyRes.PurchaseStatusResponse = statusRes.GetPayload().GetPurchaseStatusResponse()
+	}
+	return buyRes, nil
+}
@@ -0,0 +1,65 @@
+package playstore
+
+import (
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+)
+
+// Link to a list of apps related to an app, e.g., "You might also like" or "More by developer"
+type RelatedList struct {
+	Label string `json:"label"`
+	Url   string `json:"url"`
+}
+
+/**
+Get the links to the lists related to an app
+
+Links are collected from DocV2 related links and the DocV1 related and "more by" lists,
+lists with the same URL are returned once. Use `GetAppList` to get the apps of a list
+*/
+func (client *Client) GetRelatedLists(packageName string) ([]RelatedList, error) {
+	detailsRes, err := client.GetDetailsResponse(packageName)
+	if err != nil {
+		return nil, err
+	}
+
+	var lists []RelatedList
+	seen := map[string]bool{}
+
+	addList := func(label string, listUrl string) {
+		if listUrl == "" || seen[listUrl] {
+			return
+		}
+		seen[listUrl] = true
+		lists = append(lists, RelatedList{Label: label, Url: listUrl})
+	}
+
+	relatedLinks := detailsRes.DocV2.GetRelatedLinks()
+	for _, link := range append([]*pb.RelatedLink{relatedLinks.GetYouMightAlsoLike()}, relatedLinks.GetRelatedLinks()...) {
+		if link == nil {
+			continue
+		}
+		listUrl := link.GetUrl1()
+		if listUrl == "" {
+			listUrl = link.GetUrl2()
+		}
+		addList(link.GetLabel(), listUrl)
+	}
+
+	docV1 := detailsRes.GetDocV1()
+	addList(docV1.GetRelatedHeader(), docV1.GetRelatedListUrl())
+	addList(docV1.GetMoreByHeader(), docV1.GetMoreByListUrl())
+	return lists, nil
+}
+
+/**
+Get all apps of a list, following the next page links
+
+`listUrl` may be relative to the FDFE endpoint, as the links in responses are
+*/
+func (client *Client) GetAppList(listUrl string) ([]*pb.DocV2, error) {
+	listUrl, err := resolveFDFEUrl(listUrl)
+	if err != nil {
+		return nil, err
+	}
+	return client.getAppList(listUrl)
+}
@@ -0,0 +1,48 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+)
+
+const TestingProgramUrl = FDFEUrl + "apps/testingProgram"
+
+/**
+Get the beta testing program enrollment of an app
+
+Returns nil if the app does not have a testing program
+*/
+func (client *Client) GetTestingProgram(packageName string) (*pb.TestingProgramInfo, error) {
+	doc, err := client.GetDetails(packageName)
+	if err != nil {
+		return nil, err
+	}
+	return doc.GetDetails().GetAppDetails().GetTestingProgramInfo(), nil
+}
+
+/**
+Join (`subscribe` is true) or leave the beta testing program of an app
+
+After joining, downloads of the latest version return the beta build.
+The enrollment may take a while to take effect on the server.
+The cached details of the app are removed, so the enrollment and the latest version are fetched again
+*/
+func (client *Client) SetTestingProgram(packageName string, subscribe bool) (*pb.TestingProgramResponse, error) {
+	res, err := client.sendProto(TestingProgramUrl, &pb.TestingProgramRequest{
+		PackageName: proto.String(packageName),
+		Subscribe:   proto.Bool(subscribe),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if client.cache != nil {
+		client.cache.Delete(client.cacheKey(detailsUrl(packageName)))
+	}
+
+	testingProgramRes := res.GetPayload().GetTestingProgramResponse()
+	if testingProgramRes == nil {
+		return nil, fmt.Errorf("response does not contain testing program response")
+	}
+	return testingProgramRes, nil
+}
@@ -0,0 +1,41 @@
+package playstore
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSetTestingProgramInvalidatesDetails(t *testing.T) {
+	subscribed := false
+
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"details": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			res := detailsResponse(TestPackageName, 10)
+			res.Payload.DetailsResponse.DocV2.Details.AppDetails.TestingProgramInfo = &pb.TestingProgramInfo{
+				Subscribed: proto.Bool(subscribed),
+			}
+			return res, nil
+		},
+		"testingProgram": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			subscribed = true
+			return &pb.ResponseWrapper{Payload: &pb.Payload{TestingProgramResponse: &pb.TestingProgramResponse{}}}, nil
+		},
+	}}
+	client := createFakePlayStoreClient(t, store, &Config{
+		Cache:    NewMemoryCache(DefaultMemoryCacheSize),
+		CacheTTL: time.Hour,
+	})
+
+	if info, err := client.GetTestingProgram(TestPackageName); err != nil || info.GetSubscribed() {
+		t.Fatalf("Testing program should not be subscribed: %v, %v", info, err)
+	}
+	if _, err := client.SetTestingProgram(TestPackageName, true); err != nil {
+		t.Fatalf("Could not join testing program: %v", err)
+	}
+	if info, err := client.GetTestingProgram(TestPackageName); err != nil || !info.GetSubscribed() {
+		t.Fatalf("Cached enrollment was returned after joining: %v, %v", info, err)
+	}
+}
@@ -0,0 +1,223 @@
+package playstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Signing certificates of an app, pinned when the app was first seen
+type CertificatePin struct {
+	// SHA-256 of the APK signing certificates as lowercase hex
+	CertSha256 []string `json:"certSha256,omitempty"`
+	// LibraryAppDetails.certificateHash of the account library. The format is not documented, so it is compared as is
+	LibraryCertificateHash string    `json:"libraryCertificateHash,omitempty"`
+	VersionCode            int       `json:"versionCode,omitempty"`
+	Pinned                 time.Time `json:"pinned"`
+	Verified               time.Time `json:"verified"`
+}
+
+// Pinned certificates by package name
+type TrustPins map[string]CertificatePin
+
+// The signing certificate of an app is different from the pinned one
+type CertificateMismatchError struct {
+	PackageName string
+	Pinned      []string
+	Actual      []string
+}
+
+func (err *CertificateMismatchError) Error() string {
+	return fmt.Sprintf("signing certificate of %s has changed from %s to %s",
+		err.PackageName, strings.Join(err.Pinned, ", "), strings.Join(err.Actual, ", "))
+}
+
+/**
+Trust on first use store of app signing certificates
+
+The certificates of an app are pinned when the app is first verified, later versions must be signed
+with exactly the pinned certificates. New certificates, e.g., after key rotation, are never trusted automatically,
+the pin must be reset. The certificates are compared as the APK reports them,
+the signatures themselves are verified by Android on install
+*/
+type TrustStore struct {
+	path string
+
+	mutex sync.Mutex
+	pins  TrustPins
+}
+
+/**
+Load the pins from `path`, the store is empty if the file does not exist.
+The pins are kept only in memory if the path is empty
+*/
+func LoadTrustStore(path string) (*TrustStore, error) {
+	store := &TrustStore{path: path, pins: TrustPins{}}
+	if path == "" {
+		return store, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &store.pins); err != nil {
+		return nil, fmt.Errorf("could not parse trust store: %v", err)
+	}
+	return store, nil
+}
+
+// Copy of the pins
+func (store *TrustStore) Pins() TrustPins {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	pins := make(TrustPins, len(store.pins))
+	for packageName, pin := range store.pins {
+		pin.CertSha256 = append([]string{}, pin.CertSha256...)
+		pins[packageName] = pin
+	}
+	return pins
+}
+
+/**
+Check the APK signing certificates of an app version against the pin, see `apkinfo.ApkInfo.SigningCertSha256`
+
+The certificates are pinned if the app does not have pinned APK certificates yet, in which case `pinned` is true.
+Returns *CertificateMismatchError if the certificates are not the pinned ones, the pin is not changed then
+*/
+func (store *TrustStore) Verify(packageName string, versionCode int, certSha256 []string) (pinned bool, err error) {
+	if len(certSha256) == 0 {
+		return false, fmt.Errorf("%s does not have signing certificates", packageName)
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := time.Now().UTC()
+	pin, ok := store.pins[packageName]
+	if !ok {
+		pin.Pinned = now
+	}
+
+	certs := uniqueSorted(certSha256)
+
+	pinned = len(pin.CertSha256) == 0
+	if !pinned && !equalStrings(uniqueSorted(pin.CertSha256), certs) {
+		return false, &CertificateMismatchError{PackageName: packageName, Pinned: pin.CertSha256, Actual: certs}
+	}
+
+	pin.CertSha256 = certs
+	pin.VersionCode = versionCode
+	pin.Verified = now
+	store.pins[packageName] = pin
+	return pinned, store.save()
+}
+
+/**
+Check the certificate hash the account library reports for an app, see `GetLibraryCertificateHashes`
+
+Pins the hash if the app does not have a pinned library hash yet, in which case `pinned` is true.
+Returns *CertificateMismatchError if the hash is different from the pinned one
+*/
+func (store *TrustStore) VerifyLibraryHash(packageName string, certificateHash string) (pinned bool, err error) {
+	if certificateHash == "" {
+		return false, fmt.Errorf("library does not have certificate hash of %s", packageName)
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := time.Now().UTC()
+	pin, ok := store.pins[packageName]
+	if !ok {
+		pin.Pinned = now
+	}
+
+	pinned = pin.LibraryCertificateHash == ""
+	if !pinned && pin.LibraryCertificateHash != certificateHash {
+		return false, &CertificateMismatchError{
+			PackageName: packageName,
+			Pinned:      []string{pin.LibraryCertificateHash},
+			Actual:      []string{certificateHash},
+		}
+	}
+
+	pin.LibraryCertificateHash = certificateHash
+	pin.Verified = now
+	store.pins[packageName] = pin
+	return pinned, store.save()
+}
+
+/**
+Remove the pins of the apps, all pins if no package names are given.
+The next verification pins the certificates again
+*/
+func (store *TrustStore) Reset(packageNames ...string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if len(packageNames) == 0 {
+		store.pins = TrustPins{}
+	}
+	for _, packageName := range packageNames {
+		delete(store.pins, packageName)
+	}
+	return store.save()
+}
+
+// Write to a temporary file first, so an interrupted write does not corrupt the pins
+func (store *TrustStore) save() error {
+	if store.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(store.pins, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(store.path), 0700); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(store.path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(store.path+".tmp", store.path)
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sorted copy without duplicates
+func uniqueSorted(values []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
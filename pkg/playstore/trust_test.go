@@ -0,0 +1,62 @@
+package playstore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTrustStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gplay-trust")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	storePath := filepath.Join(dir, "trust", "pins.json")
+	store, err := LoadTrustStore(storePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pinned, err := store.Verify(TestPackageName, 1, []string{"aa"}); err != nil || !pinned {
+		t.Fatalf("First verification should pin the certificate: %v, %v", pinned, err)
+	}
+	// Certificates of a foreign signer are not trusted, even with the pinned one
+	_, err = store.Verify(TestPackageName, 2, []string{"aa", "bb"})
+	if _, ok := err.(*CertificateMismatchError); !ok {
+		t.Fatalf("Additional certificate should not match: %v", err)
+	}
+	if pinned, err := store.Verify(TestPackageName, 2, []string{"aa"}); err != nil || pinned {
+		t.Fatalf("Pinned certificate should match: %v, %v", pinned, err)
+	}
+
+	store, err = LoadTrustStore(storePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pin := store.Pins()[TestPackageName]; len(pin.CertSha256) != 1 || pin.VersionCode != 2 {
+		t.Fatalf("Pin was not saved: %+v", pin)
+	}
+
+	_, err = store.Verify(TestPackageName, 4, []string{"cc"})
+	if _, ok := err.(*CertificateMismatchError); !ok {
+		t.Fatalf("Different certificate should not match: %v", err)
+	}
+
+	if _, err = store.VerifyLibraryHash(TestPackageName, "hash"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = store.VerifyLibraryHash(TestPackageName, "other")
+	if _, ok := err.(*CertificateMismatchError); !ok {
+		t.Fatalf("Different library hash should not match: %v", err)
+	}
+
+	if err = store.Reset(TestPackageName); err != nil {
+		t.Fatal(err)
+	}
+	if pinned, err := store.Verify(TestPackageName, 4, []string{"cc"}); err != nil || !pinned {
+		t.Fatalf("Reset should remove the pin: %v, %v", pinned, err)
+	}
+}
@@ -0,0 +1,117 @@
+package playstore
+
+import (
+	"fmt"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	log "github.com/sirupsen/logrus"
+	"sort"
+)
+
+const defaultMaxVersionProbes = 50
+
+type AvailableVersion struct {
+	VersionCode int `json:"versionCode"`
+	// Size of the base APK
+	Size int64 `json:"size"`
+	// Size of the base APK and its splits
+	TotalSize int64 `json:"totalSize"`
+}
+
+type VersionProbeOptions struct {
+	// How many version codes below the latest are probed, 50 if zero
+	MaxProbes int
+	// Stop after this many versions are found, no limit if zero
+	MaxVersions int
+}
+
+/**
+Find the past versions of an app that the server still delivers
+
+The server does not list the past versions, so the version codes are probed using delivery:
+the latest version, the version codes in the app details files, and then the version codes
+counting down from the latest. Each probe is a request, so the search is bounded by `opts.MaxProbes`.
+Version codes that are not delivered are skipped, other delivery errors fail the search
+
+Returns the versions ordered from the latest to the oldest
+*/
+func (client *Client) GetAvailableVersions(packageName string, opts *VersionProbeOptions) ([]AvailableVersion, error) {
+	if opts == nil {
+		opts = &VersionProbeOptions{}
+	}
+	maxProbes := opts.MaxProbes
+	if maxProbes == 0 {
+		maxProbes = defaultMaxVersionProbes
+	}
+
+	doc, err := client.GetDetails(packageName)
+	if err != nil {
+		return nil, err
+	}
+	appDetails := doc.GetDetails().GetAppDetails()
+	latestVersionCode := int(appDetails.GetVersionCode())
+	// Delivery of version code zero is the latest version, which would be probed as a past version
+	if latestVersionCode == 0 {
+		return nil, fmt.Errorf("details of %s did not contain version code", packageName)
+	}
+
+	// Acquires the app if needed, past versions are delivered only to accounts that own the app
+	latest, err := client.GetAppDeliveryData(packageName, latestVersionCode)
+	if err != nil {
+		return nil, err
+	}
+	versions := []AvailableVersion{newAvailableVersion(latestVersionCode, latest)}
+
+	var candidates []int
+	for _, file := range appDetails.GetFile() {
+		if int(file.GetVersionCode()) < latestVersionCode {
+			candidates = append(candidates, int(file.GetVersionCode()))
+		}
+	}
+	for i := 1; i <= maxProbes && latestVersionCode-i > 0; i++ {
+		candidates = append(candidates, latestVersionCode-i)
+	}
+
+	probed := map[int]bool{latestVersionCode: true}
+	deliveryOpts := &DeliveryOptions{OfferType: offerType(selectOffer(doc))}
+
+	for _, versionCode := range candidates {
+		if probed[versionCode] {
+			continue
+		}
+		probed[versionCode] = true
+
+		if opts.MaxVersions > 0 && len(versions) >= opts.MaxVersions {
+			break
+		}
+
+		deliveryData, err := client.Delivery(packageName, versionCode, deliveryOpts)
+		if err == ErrNotDelivered {
+			log.Debugf("%s version %d is not available", packageName, versionCode)
+			continue
+		}
+		// E.g., expired auth or rate limiting, returned as is so the typed errors can be checked
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debugf("%s version %d is available", packageName, versionCode)
+		versions = append(versions, newAvailableVersion(versionCode, deliveryData))
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].VersionCode > versions[j].VersionCode
+	})
+	return versions, nil
+}
+
+func newAvailableVersion(versionCode int, deliveryData *pb.AndroidAppDeliveryData) AvailableVersion {
+	version := AvailableVersion{
+		VersionCode: versionCode,
+		Size:        deliveryData.GetDownloadSize(),
+		TotalSize:   deliveryData.GetDownloadSize(),
+	}
+	for _, split := range deliveryData.GetSplit() {
+		version.TotalSize += split.GetSize()
+	}
+	return version
+}
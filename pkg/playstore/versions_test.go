@@ -0,0 +1,69 @@
+package playstore
+
+import (
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetAvailableVersionsWithoutVersionCode(t *testing.T) {
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"details": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			return detailsResponse(TestPackageName, 0), nil
+		},
+	}}
+	client := createFakePlayStoreClient(t, store, nil)
+
+	if versions, err := client.GetAvailableVersions(TestPackageName, nil); err == nil {
+		t.Fatalf("Versions without latest version code should fail: %+v", versions)
+	}
+	if store.requested("delivery") != 0 {
+		t.Fatalf("Versions should not be probed without latest version code")
+	}
+}
+
+// Latest version 5 is delivered, 4 is not delivered anymore and probing 2 fails with `probeErr`
+func createVersionsTestStore(probeErr error) *fakePlayStore {
+	return &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"details": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			return detailsResponse(TestPackageName, 5), nil
+		},
+		"delivery": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			deliveryRes := &pb.DeliveryResponse{}
+			switch req.URL.Query().Get("vc") {
+			case "2":
+				return nil, probeErr
+			case "4":
+				// Not delivered
+			default:
+				deliveryRes.AppDeliveryData = &pb.AndroidAppDeliveryData{DownloadSize: proto.Int64(100)}
+			}
+			return &pb.ResponseWrapper{Payload: &pb.Payload{DeliveryResponse: deliveryRes}}, nil
+		},
+	}}
+}
+
+func TestGetAvailableVersionsNotDelivered(t *testing.T) {
+	client := createFakePlayStoreClient(t, createVersionsTestStore(errors.New("connection reset")), nil)
+
+	versions, err := client.GetAvailableVersions(TestPackageName, &VersionProbeOptions{MaxProbes: 2})
+	if err != nil {
+		t.Fatalf("Could not get versions: %v", err)
+	}
+	if len(versions) != 2 || versions[0].VersionCode != 5 || versions[1].VersionCode != 3 {
+		t.Fatalf("Versions are incorrect: %+v", versions)
+	}
+}
+
+func TestGetAvailableVersionsProbeError(t *testing.T) {
+	probeErr := errors.New("connection reset")
+	client := createFakePlayStoreClient(t, createVersionsTestStore(probeErr), nil)
+
+	versions, err := client.GetAvailableVersions(TestPackageName, &VersionProbeOptions{MaxProbes: 4})
+	if err == nil || !strings.Contains(err.Error(), probeErr.Error()) {
+		t.Fatalf("Probe error was not returned: %+v, %v", versions, err)
+	}
+}
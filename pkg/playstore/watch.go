@@ -0,0 +1,273 @@
+package playstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const DefaultWatchInterval = time.Hour
+
+// New version of a watched app
+type VersionChange struct {
+	PackageName      string `json:"packageName"`
+	OldVersionCode   int    `json:"oldVersionCode"`
+	OldVersionString string `json:"oldVersionString"`
+	NewVersionCode   int    `json:"newVersionCode"`
+	NewVersionString string `json:"newVersionString"`
+	// Changelog of the new version
+	RecentChangesHtml string    `json:"recentChangesHtml"`
+	Detected          time.Time `json:"detected"`
+}
+
+type WatchedVersion struct {
+	VersionCode   int       `json:"versionCode"`
+	VersionString string    `json:"versionString"`
+	Checked       time.Time `json:"checked"`
+}
+
+// Last seen versions by package name
+type WatchState map[string]WatchedVersion
+
+/**
+Polls the latest versions of the watched apps and reports the changed versions
+
+The last seen versions are saved to the state file, so changes between runs are reported on the next check.
+The first check of an app only records its version. A new version is saved only when the change is acknowledged
+with `Acknowledge` after it was handled, until then it is reported again on every check (at-least-once delivery)
+*/
+type Watcher struct {
+	client       *Client
+	packageNames []string
+	statePath    string
+
+	mutex sync.Mutex
+	state WatchState
+}
+
+/**
+Create watcher, loads the last seen versions from `statePath`. The state is kept only in memory if the path is empty
+*/
+func NewWatcher(client *Client, packageNames []string, statePath string) (*Watcher, error) {
+	watcher := &Watcher{
+		client:       client,
+		packageNames: packageNames,
+		statePath:    statePath,
+		state:        WatchState{},
+	}
+
+	if statePath == "" {
+		return watcher, nil
+	}
+
+	data, err := ioutil.ReadFile(statePath)
+	if os.IsNotExist(err) {
+		return watcher, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &watcher.state); err != nil {
+		return nil, fmt.Errorf("could not parse watch state: %v", err)
+	}
+	return watcher, nil
+}
+
+// Copy of the last seen versions
+func (watcher *Watcher) State() WatchState {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	state := make(WatchState, len(watcher.state))
+	for packageName, version := range watcher.state {
+		state[packageName] = version
+	}
+	return state
+}
+
+/**
+Check the latest versions once and save the versions of the apps seen first time
+
+Apps the server did not return details or a version code for, e.g., removed or region locked apps,
+are logged and checked again on the next check. The changes are saved by `Acknowledge`
+*/
+func (watcher *Watcher) Check() ([]VersionChange, error) {
+	docs, err := watcher.client.GetBulkDetails(watcher.packageNames)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	now := time.Now().UTC()
+	var changes []VersionChange
+
+	for i, packageName := range watcher.packageNames {
+		if docs[i] == nil {
+			log.Warnf("Could not check version of %s, details not found", packageName)
+			continue
+		}
+
+		appDetails := docs[i].GetDetails().GetAppDetails()
+		if appDetails.GetVersionCode() == 0 {
+			log.Warnf("Could not check version of %s, details do not have version code", packageName)
+			continue
+		}
+
+		latest := WatchedVersion{
+			VersionCode:   int(appDetails.GetVersionCode()),
+			VersionString: appDetails.GetVersionString(),
+			Checked:       now,
+		}
+
+		previous, seen := watcher.state[packageName]
+		if !seen || latest.VersionCode == previous.VersionCode {
+			watcher.state[packageName] = latest
+			continue
+		}
+
+		changes = append(changes, VersionChange{
+			PackageName:       packageName,
+			OldVersionCode:    previous.VersionCode,
+			OldVersionString:  previous.VersionString,
+			NewVersionCode:    latest.VersionCode,
+			NewVersionString:  latest.VersionString,
+			RecentChangesHtml: appDetails.GetRecentChangesHtml(),
+			Detected:          now,
+		})
+	}
+
+	if err = watcher.saveState(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+/**
+Save the new version of the change, after the change was handled.
+Ignored if the saved version is no longer the old version of the change
+*/
+func (watcher *Watcher) Acknowledge(change VersionChange) error {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if watcher.state[change.PackageName].VersionCode != change.OldVersionCode {
+		return nil
+	}
+
+	watcher.state[change.PackageName] = WatchedVersion{
+		VersionCode:   change.NewVersionCode,
+		VersionString: change.NewVersionString,
+		Checked:       change.Detected,
+	}
+	return watcher.saveState()
+}
+
+// Write to a temporary file first, so an interrupted write does not corrupt the state
+func (watcher *Watcher) saveState() error {
+	if watcher.statePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(watcher.state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(watcher.statePath+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(watcher.statePath+".tmp", watcher.statePath)
+}
+
+/**
+Check immediately and then every `interval` until the context is done
+
+The changes are sent to the returned channel, which is closed when the watcher stops.
+Acknowledge the handled changes, the others are sent again on the next interval.
+Failed checks are logged and retried on the next interval
+*/
+func (watcher *Watcher) Watch(ctx context.Context, interval time.Duration) <-chan VersionChange {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	changesChan := make(chan VersionChange)
+
+	go func() {
+		defer close(changesChan)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			changes, err := watcher.Check()
+			if err != nil {
+				log.Errorf("Version check failed: %v", err)
+			}
+
+			for _, change := range changes {
+				select {
+				case changesChan <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changesChan
+}
+
+// Handles version changes, e.g., sends notifications
+type ChangeHandler func(change VersionChange) error
+
+/**
+Write each change as a JSON object on its own line
+*/
+func JSONLinesHandler(w io.Writer) ChangeHandler {
+	encoder := json.NewEncoder(w)
+	return func(change VersionChange) error {
+		return encoder.Encode(change)
+	}
+}
+
+/**
+POST each change as JSON to the URL, non-2xx response status is an error
+*/
+func WebhookHandler(url string) ChangeHandler {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+
+	return func(change VersionChange) error {
+		body, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+
+		res, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return fmt.Errorf("webhook returned status code: %d", res.StatusCode)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,99 @@
+package playstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/jarijaas/go-gplayapi/pkg/playstore/pb"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWatchHandlers(t *testing.T) {
+	change := VersionChange{PackageName: TestPackageName, OldVersionCode: 1, NewVersionCode: 2}
+
+	var posted VersionChange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &posted); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	if err := WebhookHandler(server.URL)(change); err != nil {
+		t.Fatal(err)
+	}
+	if posted.NewVersionCode != 2 {
+		t.Fatalf("Webhook did not receive the change: %+v", posted)
+	}
+
+	var buf bytes.Buffer
+	handler := JSONLinesHandler(&buf)
+	if err := handler(change); err != nil {
+		t.Fatal(err)
+	}
+	if err := handler(change); err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := bytes.Count(buf.Bytes(), []byte("\n")); lines != 2 {
+		t.Fatalf("Expected 2 JSON lines, got %d: %s", lines, buf.String())
+	}
+}
+
+func TestWatcherCheck(t *testing.T) {
+	versions := map[string]int32{TestPackageName: 1, "com.example.removed": 5}
+
+	store := &fakePlayStore{responses: map[string]func(req *http.Request) (*pb.ResponseWrapper, error){
+		"bulkDetails": func(req *http.Request) (*pb.ResponseWrapper, error) {
+			bulkRes := &pb.BulkDetailsResponse{}
+			for packageName, versionCode := range versions {
+				doc := detailsResponse(packageName, versionCode).GetPayload().GetDetailsResponse().GetDocV2()
+				bulkRes.Entry = append(bulkRes.Entry, &pb.BulkDetailsEntry{Doc: doc})
+			}
+			return &pb.ResponseWrapper{Payload: &pb.Payload{BulkDetailsResponse: bulkRes}}, nil
+		},
+	}}
+	client := createFakePlayStoreClient(t, store, nil)
+
+	watcher, err := NewWatcher(client, []string{TestPackageName, "com.example.removed"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// First check records the baseline
+	if changes, err := watcher.Check(); err != nil || len(changes) != 0 {
+		t.Fatalf("First check should not report changes: %v, %v", changes, err)
+	}
+	if state := watcher.State(); state[TestPackageName].VersionCode != 1 || state["com.example.removed"].VersionCode != 5 {
+		t.Fatalf("Baseline is incorrect: %+v", state)
+	}
+
+	// Version code 0 is not a version
+	versions[TestPackageName] = 2
+	versions["com.example.removed"] = 0
+
+	changes, err := watcher.Check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].OldVersionCode != 1 || changes[0].NewVersionCode != 2 {
+		t.Fatalf("Changes are incorrect: %+v", changes)
+	}
+	if watcher.State()["com.example.removed"].VersionCode != 5 {
+		t.Fatalf("Missing version code should not be recorded")
+	}
+
+	// Not acknowledged, so reported again
+	if changes, err = watcher.Check(); err != nil || len(changes) != 1 {
+		t.Fatalf("Unacknowledged change should be reported again: %v, %v", changes, err)
+	}
+	if err = watcher.Acknowledge(changes[0]); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err = watcher.Check(); err != nil || len(changes) != 0 {
+		t.Fatalf("Acknowledged change should not be reported again: %v, %v", changes, err)
+	}
+}